@@ -1,6 +1,137 @@
 package config
 
+import (
+	"os"
+	"time"
+)
+
 const (
 	WorldFilePath = "/Users/ice/MMO/Assets/Editor/level.txt"
 	WorldName     = "island"
+	ContentDir    = "content" // Entity and item definitions
+)
+
+const (
+	ContentWatchInterval time.Duration = 0     // Polling interval of the content files like 5 * time.Second, 0 disables the watch
+	ContentReloadLive                  = false // Apply reloaded definitions to spawned objects, not only to new spawns
+)
+
+const (
+	RTTProbeInterval         = 2 * time.Second
+	MaxLagCompensation       = 250 * time.Millisecond // Attacks are never validated further back in time
+	ClientInterpolationDelay = 100 * time.Millisecond // Clients render other objects this far in the past
+)
+
+const (
+	RespawnDelay       = 4 * time.Second
+	RespawnPointPrefix = "respawn" // Level teleports with this name prefix are unlocked by visiting them
+	DefaultRespawn     = "main"    // Teleport of the spawn level which is always unlocked
+)
+
+type PvPMode = string
+
+const (
+	PvPOptIn  PvPMode = "opt-in" // Players who flagged themselves fight each other
+	PvPZone   PvPMode = "zone"   // Players fight inside the pvp regions of the level and when flagged
+	PvPAlways PvPMode = "always" // Everyone outside of safe zones can be attacked
+)
+
+const (
+	PvPCombatFlagDuration = 30 * time.Second // Attacking a player flags the attacker for guards
+)
+
+// AdminToken protects the admin HTTP endpoints, they are disabled when it is empty
+var AdminToken = os.Getenv("ADMIN_TOKEN")
+
+type LevelConfig struct {
+	Name       string
+	FilePath   string
+	IsInstance bool    // Loaded on demand as a private copy per party
+	PvP        PvPMode // opt-in when empty
+}
+
+// Levels hosted by the server at once, players spawn in the first one
+var Levels = []LevelConfig{
+	{Name: WorldName, FilePath: WorldFilePath, PvP: PvPOptIn},
+}
+
+// SafeZones adds no damage rectangles (X/Z) to the safe regions of the level data, by level name
+var SafeZones = map[string][]ZoneRect{}
+
+const (
+	DayLength                  = 24 * time.Minute // Real time of a full in-game day
+	DayStartHour               = 6
+	NightStartHour             = 21
+	WorldTimeBroadcastInterval = 30 * time.Second
+)
+
+const (
+	WeatherChangeMinInterval = 5 * time.Minute
+	WeatherChangeMaxInterval = 15 * time.Minute
+	WeatherTransition        = 30 * time.Second
+)
+
+// WeatherWeights is the chance of every weather type to be picked on change
+var WeatherWeights = map[string]int{
+	"clear": 6,
+	"rain":  2,
+	"fog":   1,
+	"storm": 1,
+}
+
+const (
+	SnapshotDir      = "data/snapshots" // World state snapshots, one file per level
+	SnapshotInterval = 30 * time.Second
+)
+
+const (
+	AccountsDir         = "data/accounts" // Login credentials, one file per account
+	AccountRegistration = true            // First login of an unknown account registers it with the password
+	ClaimLegacyAccounts = false           // Let the first login set the password of characters saved without an account, otherwise POST /admin/account-password sets it
 )
+
+const (
+	PlayersDir         = "data/players" // Player characters, <account>/<character>.json
+	PlayerSaveInterval = 60 * time.Second
+	MaxCharacters      = 5 // Per account
+)
+
+const (
+	MaxInstances         = 20
+	InstanceEmptyTimeout = 60 * time.Second
+)
+
+func GetLevel(name string) *LevelConfig {
+	for i := range Levels {
+		if Levels[i].Name == name {
+			return &Levels[i]
+		}
+	}
+
+	return nil
+}
+
+// TerrainLayerSurfaces maps terrain layer texture indices to surface types
+var TerrainLayerSurfaces = map[int32]string{
+	0: "grass",
+	1: "dirt",
+	2: "rock",
+	3: "sand",
+}
+
+type ZoneRect struct {
+	Name string
+	MinX float64
+	MinZ float64
+	MaxX float64
+	MaxZ float64
+}
+
+// WorldZones splits the terrain into rectangles (X/Z) simulated independently.
+// Objects outside of every rectangle belong to the closest zone.
+var WorldZones = []ZoneRect{
+	{Name: "north-west", MinX: -1000, MinZ: 0, MaxX: 0, MaxZ: 1000},
+	{Name: "north-east", MinX: 0, MinZ: 0, MaxX: 1000, MaxZ: 1000},
+	{Name: "south-west", MinX: -1000, MinZ: -1000, MaxX: 0, MaxZ: 0},
+	{Name: "south-east", MinX: 0, MinZ: -1000, MaxX: 1000, MaxZ: 0},
+}
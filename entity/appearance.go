@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+)
+
+type AppearanceSlot struct {
+	Recipes    []string
+	IsRequired bool
+}
+
+// AppearanceCatalogue lists the slot recipes a new character can choose by gender
+var AppearanceCatalogue = map[string]map[string]AppearanceSlot{
+	"male": {
+		"Hair":  {Recipes: []string{"MilCut", "MaleHair2"}},
+		"Beard": {Recipes: []string{"MaleBeard1"}},
+		"Legs":  {Recipes: []string{"MaleSweatPants_Recipe", "MalePants"}, IsRequired: true},
+		"Feet":  {Recipes: []string{"TallShoes_Black_Recipe"}},
+		"Chest": {Recipes: []string{"MaleShirt2", "MaleChallengerTorso"}, IsRequired: true},
+		"Cape":  {Recipes: []string{"CapeBasic"}},
+	},
+	"female": {
+		"Hair":  {Recipes: []string{"FemaleHair1", "FemaleHair2"}},
+		"Legs":  {Recipes: []string{"FemalePants", "FemaleShortPants"}, IsRequired: true},
+		"Feet":  {Recipes: []string{"TallShoes_Black_Recipe"}},
+		"Chest": {Recipes: []string{"FemaleShirt1", "FemaleTankTop"}, IsRequired: true},
+		"Cape":  {Recipes: []string{"CapeBasic"}},
+	},
+}
+
+// AppearanceColors is the palette for slot colours, empty colour keeps the recipe default
+var AppearanceColors = []string{
+	"#000000", "#FFFFFF", "#CACACA", "#5C4033", "#A0522D", "#D2B48C", "#FF0000", "#8B0000", "#1E3A8A", "#2E8B57",
+}
+
+// ValidateHumanCharacter checks the appearance against the catalogue
+func ValidateHumanCharacter(character *HumanCharacter) error {
+	if character == nil {
+		return fmt.Errorf("appearance is missing")
+	}
+
+	slots, ok := AppearanceCatalogue[character.Gender]
+	if !ok {
+		return fmt.Errorf("unknown gender %q", character.Gender)
+	}
+
+	for name, slot := range character.Slots {
+		options, ok := slots[name]
+		if !ok {
+			return fmt.Errorf("unknown slot %q", name)
+		}
+
+		if !slices.Contains(options.Recipes, slot.Recipe) {
+			return fmt.Errorf("recipe %q is not available for slot %s", slot.Recipe, name)
+		}
+
+		if slot.Color != "" && !slices.Contains(AppearanceColors, slot.Color) {
+			return fmt.Errorf("color %q is not available", slot.Color)
+		}
+	}
+
+	for name, options := range slots {
+		if _, ok := character.Slots[name]; options.IsRequired && !ok {
+			return fmt.Errorf("slot %s is required", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package entity
+
+type TimeOfDay = string
+
+const (
+	TimeDay   TimeOfDay = "day"
+	TimeNight TimeOfDay = "night"
+)
+
+// Behaviour overrides NPC settings for a time of day
+type Behaviour struct {
+	IsHidden   bool    // NPC is despawned and doesn't respawn
+	AgroRadius float32 // 0 keeps the default radius
+	IsResting  bool    // NPC doesn't patrol between waypoints
+}
+
+func (e *Entity) GetBehaviour(timeOfDay TimeOfDay) Behaviour {
+	if behaviour, ok := e.TimeOfDayBehaviours[timeOfDay]; ok {
+		return behaviour
+	}
+
+	return Behaviour{}
+}
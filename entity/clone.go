@@ -0,0 +1,45 @@
+package entity
+
+import "maps"
+
+// Clone returns a deep copy, objects get their own equipment, clip, reload timer and appearance
+// instead of sharing the template state
+func (e Entity) Clone() Entity {
+	clone := e
+
+	if e.EquippedItems != nil {
+		clone.EquippedItems = &EquippedItems{
+			RightHand: e.EquippedItems.RightHand.Clone(),
+			LeftHand:  e.EquippedItems.LeftHand.Clone(),
+		}
+	}
+
+	if e.ReloadFinishTime != nil {
+		reloadFinishTime := *e.ReloadFinishTime
+		clone.ReloadFinishTime = &reloadFinishTime
+	}
+
+	if e.HumanCharacter != nil {
+		clone.HumanCharacter = &HumanCharacter{
+			Gender: e.HumanCharacter.Gender,
+			Slots:  maps.Clone(e.HumanCharacter.Slots),
+		}
+	}
+
+	if e.Collider != nil {
+		collider := *e.Collider
+		clone.Collider = &collider
+	}
+
+	if e.SpawnSurfaces != nil {
+		clone.SpawnSurfaces = append([]string{}, e.SpawnSurfaces...)
+	}
+
+	if e.Effects != nil {
+		clone.Effects = append([]StatusEffect{}, e.Effects...)
+	}
+
+	clone.TimeOfDayBehaviours = maps.Clone(e.TimeOfDayBehaviours)
+
+	return clone
+}
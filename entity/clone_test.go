@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testPistol = `{
+  "Name": "Colt",
+  "InternalName": "pistol",
+  "Type": "pistol",
+  "ClipSize": 10,
+  "Clip": 10,
+  "AttackDamage": 1,
+  "AttackRange": 5,
+  "AttackSpeed": 1
+}`
+
+const testBandit = `{
+  "Name": "Bandit",
+  "InternalName": "bandit",
+  "MaxHealth": 100,
+  "Health": 100,
+  "CanAgro": true,
+  "HumanCharacter": {
+    "Gender": "male",
+    "Slots": { "Hair": { "Recipe": "MilCut", "Color": "#FFFFFF" } }
+  },
+  "EquippedItems": { "RightHand": "pistol" }
+}`
+
+func writeTestContent(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"items/pistol.json":    testPistol,
+		"entities/bandit.json": testBandit,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func mutate(e *Entity) {
+	reloadFinishTime := time.Now().Add(time.Minute)
+	e.EquippedItems.RightHand.Clip = 0
+	e.EquippedItems.RightHand.ReloadFinishTime = &reloadFinishTime
+	e.HumanCharacter.Slots["Hair"] = HumanSlot{Recipe: "Bald"}
+	e.Health = 1
+}
+
+func assertPristine(t *testing.T, name string, e Entity) {
+	t.Helper()
+
+	if e.EquippedItems.RightHand.Clip != 10 {
+		t.Errorf("%s: clip = %d, want 10", name, e.EquippedItems.RightHand.Clip)
+	}
+	if e.EquippedItems.RightHand.ReloadFinishTime != nil {
+		t.Errorf("%s: reload timer is shared", name)
+	}
+	if e.HumanCharacter.Slots["Hair"].Recipe != "MilCut" {
+		t.Errorf("%s: appearance is shared", name)
+	}
+	if e.Health != 100 {
+		t.Errorf("%s: health = %d, want 100", name, e.Health)
+	}
+}
+
+func TestEntityFactoryDoesNotShareState(t *testing.T) {
+	dir := writeTestContent(t)
+	if err := LoadContent(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	first := EntityFactory("bandit")
+	second := EntityFactory("bandit")
+	mutate(&first)
+
+	assertPristine(t, "second", second)
+	assertPristine(t, "new spawn", EntityFactory("bandit"))
+
+	// Reloading the content doesn't leak the state of spawned objects into the new templates
+	if err := LoadContent(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := EntityFactory("bandit")
+	assertPristine(t, "second after reload", second)
+	assertPristine(t, "spawn after reload", reloaded)
+
+	mutate(&reloaded)
+	assertPristine(t, "spawn after reload", EntityFactory("bandit"))
+}
+
+func TestGetItemDoesNotShareState(t *testing.T) {
+	if err := LoadContent(writeTestContent(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	first := GetItem("pistol")
+	reloadFinishTime := time.Now()
+	first.Clip = 0
+	first.ReloadFinishTime = &reloadFinishTime
+
+	second := GetItem("pistol")
+	if second.Clip != 10 || second.ReloadFinishTime != nil {
+		t.Errorf("second item shares state: clip %d, reload timer %v", second.Clip, second.ReloadFinishTime)
+	}
+}
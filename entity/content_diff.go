@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+type DefinitionChange struct {
+	Name   string
+	Fields []FieldChange
+}
+
+// ContentDiff describes what a content reload changed
+type ContentDiff struct {
+	AddedEntities   []string
+	RemovedEntities []string
+	ChangedEntities []DefinitionChange
+	AddedItems      []string
+	RemovedItems    []string
+	ChangedItems    []DefinitionChange
+}
+
+func (d *ContentDiff) IsEmpty() bool {
+	return len(d.AddedEntities)+len(d.RemovedEntities)+len(d.ChangedEntities)+len(d.AddedItems)+len(d.RemovedItems)+len(d.ChangedItems) == 0
+}
+
+// ReloadContent validates the content files and replaces the definitions, current ones are kept on error
+func ReloadContent(dir string) (*ContentDiff, error) {
+	entities, items, err := readContent(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	Content.Lock()
+	diff := &ContentDiff{}
+	diff.AddedEntities, diff.RemovedEntities, diff.ChangedEntities = diffDefinitions(Content.entities, entities)
+	diff.AddedItems, diff.RemovedItems, diff.ChangedItems = diffDefinitions(Content.items, items)
+	Content.entities = entities
+	Content.items = items
+	Content.Unlock()
+
+	return diff, nil
+}
+
+func diffDefinitions(prev, next map[string]Entity) (added, removed []string, changed []DefinitionChange) {
+	for name, entity := range next {
+		// Aliases repeat the definition
+		if entity.InternalName != name {
+			continue
+		}
+
+		prevEntity, ok := prev[name]
+		if !ok {
+			added = append(added, name)
+			continue
+		}
+
+		if fields := diffFields(prevEntity, entity); len(fields) > 0 {
+			changed = append(changed, DefinitionChange{Name: name, Fields: fields})
+		}
+	}
+
+	for name, entity := range prev {
+		if _, ok := next[name]; entity.InternalName == name && !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Name < changed[j].Name })
+
+	return added, removed, changed
+}
+
+func diffFields(prev, next Entity) []FieldChange {
+	var changes []FieldChange
+
+	prevValue := reflect.ValueOf(prev)
+	nextValue := reflect.ValueOf(next)
+
+	for i := 0; i < prevValue.NumField(); i++ {
+		prevField := prevValue.Field(i)
+		nextField := nextValue.Field(i)
+
+		if reflect.DeepEqual(prevField.Interface(), nextField.Interface()) {
+			continue
+		}
+
+		changes = append(changes, FieldChange{
+			Field: prevValue.Type().Field(i).Name,
+			Old:   formatField(prevField),
+			New:   formatField(nextField),
+		})
+	}
+
+	return changes
+}
+
+func formatField(value reflect.Value) string {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		value = value.Elem()
+	}
+
+	if equippedItems, ok := value.Interface().(EquippedItems); ok {
+		return fmt.Sprintf("{RightHand:%s LeftHand:%s}", equippedItems.RightHand.InternalName, equippedItems.LeftHand.InternalName)
+	}
+
+	return fmt.Sprintf("%+v", value.Interface())
+}
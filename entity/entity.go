@@ -20,11 +20,30 @@ type HumanCharacter struct {
 	Slots  map[string]HumanSlot
 }
 
+type ColliderShape = string
+
+const (
+	ColliderBox     ColliderShape = "box"
+	ColliderCapsule ColliderShape = "capsule"
+)
+
+// Collider is a collision shape of a static object before the level scale and rotation are applied.
+// Shapes stand on the object position: capsules grow up along Y, boxes are centered on X/Z.
+type Collider struct {
+	Shape  ColliderShape
+	Radius float32 // Capsule radius
+	Height float32
+	Width  float32 // Box size on X
+	Length float32 // Box size on Z
+}
+
 type EntityType = string
 
 const (
 	TypePistol EntityType = "pistol"
 	TypeAxe    EntityType = "axe"
+
+	TypeConsumable EntityType = "consumable"
 )
 
 type Entity struct {
@@ -39,34 +58,47 @@ type Entity struct {
 	DamageSound     string
 	CanAgro         bool
 
+	AgroRadius    float32 // Distance NPC notices players at, 0 uses the default
+	LeashDistance float32 // Distance from spawn NPC chases up to before evading home, 0 uses the default
+	ChaseDistance float32 // Distance to the target NPC gives up the chase at, 0 chases up to the leash
+	EvadeHealRate float32 // Percent of MaxHealth healed per second while evading, 0 uses the default
+	IsGuard       bool    // Attacks only PvP flagged players inside safe zones
+
 	EquippedItems *EquippedItems
 
 	Variation    string // basic, dragon, etc
 	ClipSize     int    // for pistols, guns, etc
 	Clip         int    // current clip size
 	ReloadTime   float32
-	AttackDamage int32
+	AttackDamage int32 // Max damage
 	AttackRange  float32
 	AttackSpeed  float32
 	AttackRadius float32
 
+	AttackArc   float32 // Degrees of the melee swing in front of the attacker, everyone in reach inside it is hit, 0 hits only the target
+	IsExplosive bool    // Hit splashes AttackRadius around the target
+
+	ProjectileSpeed    float32 // Meters per second, 0 hits instantly
+	ProjectileRange    float32 // 0 flies up to AttackRange
+	ProjectileResource string
+
+	AttackMinDamage int32   // 0 always deals AttackDamage
+	CritChance      float32 // 0 - 100
+	CritMultiplier  float32 // 0 uses the default multiplier
+	Armor           int32   // Reduces incoming damage with diminishing returns
+	Resistance      float32 // 0 - 100, percent of incoming damage ignored
+
+	Effects []StatusEffect // Applied to the target on weapon hit, to the user for consumables
+
 	ReloadFinishTime *time.Time // Time to finish reload
 
 	Speed          float32
 	HumanCharacter *HumanCharacter
-}
 
-func EntityFactory(internalName string) Entity {
-	switch internalName {
-	case "tree":
-		return Tree
-	case "adam":
-		return Bandit
-	case "cyber_woman":
-		return CyberWoman
-	}
+	SpawnSurfaces []string // Ground surfaces the resource grows back on, any when empty
+	Collider      *Collider
 
-	return Entity{}
+	TimeOfDayBehaviours map[TimeOfDay]Behaviour
 }
 
 func (e *Entity) CanInteract() bool {
@@ -77,6 +109,20 @@ func (e *Entity) CanInteract() bool {
 	return chance <= e.InteractChance
 }
 
+func (e *Entity) CanSpawnOn(surface string) bool {
+	if len(e.SpawnSurfaces) == 0 {
+		return true
+	}
+
+	for _, allowed := range e.SpawnSurfaces {
+		if allowed == surface {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (e *Entity) GetInteractAnimation() string {
 	var rightHand Entity
 
@@ -111,26 +157,3 @@ func (e *Entity) IsReloadWeaponInProgress() bool {
 
 	return time.Now().Before(*e.EquippedItems.RightHand.ReloadFinishTime)
 }
-
-var axes = []Entity{BasicAxe, DragonAxe}
-var pistols = []Entity{Pistol}
-var itemsMap map[string]Entity = make(map[string]Entity)
-
-func init() {
-	var items []Entity
-
-	items = append(items, axes...)
-	items = append(items, pistols...)
-
-	for _, item := range items {
-		itemsMap[item.InternalName] = item
-	}
-}
-
-func GetItem(name string) Entity {
-	if item, ok := itemsMap[name]; ok {
-		return item
-	}
-
-	return Entity{}
-}
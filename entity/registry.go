@@ -0,0 +1,337 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"server/config"
+	"sync"
+)
+
+// Definition is an entity or item as written in a content file.
+// Equipment is referenced by item internal name.
+type Definition struct {
+	Entity
+	Aliases       []string // Level object names resolved to this entity
+	EquippedItems *struct {
+		RightHand string
+		LeftHand  string
+	}
+}
+
+type Registry struct {
+	sync.RWMutex
+	entities map[string]Entity // By internal name and aliases
+	items    map[string]Entity
+}
+
+var Content = &Registry{
+	entities: map[string]Entity{},
+	items:    map[string]Entity{},
+}
+
+// LoadContent reads <dir>/items/*.json and <dir>/entities/*.json, nothing is replaced when any file is invalid
+func LoadContent(dir string) error {
+	entities, items, err := readContent(dir)
+	if err != nil {
+		return err
+	}
+
+	Content.Lock()
+	Content.entities = entities
+	Content.items = items
+	Content.Unlock()
+
+	fmt.Printf("Content loaded: %d entities, %d items\n", len(entities), len(items))
+
+	return nil
+}
+
+func readContent(dir string) (map[string]Entity, map[string]Entity, error) {
+	var errs []error
+
+	items := map[string]Entity{}
+	itemPaths, itemDefinitions, err := readDefinitions(filepath.Join(dir, "items"))
+	errs = append(errs, err)
+
+	for i, definition := range itemDefinitions {
+		path := itemPaths[i]
+		if definitionErrs := validateDefinition(definition, nil); len(definitionErrs) > 0 {
+			errs = append(errs, withPath(path, definitionErrs)...)
+			continue
+		}
+
+		if _, ok := items[definition.InternalName]; ok {
+			errs = append(errs, fmt.Errorf("%s: item %q is already defined", path, definition.InternalName))
+			continue
+		}
+
+		items[definition.InternalName] = definition.Entity
+	}
+
+	entities := map[string]Entity{}
+	entityPaths, entityDefinitions, err := readDefinitions(filepath.Join(dir, "entities"))
+	errs = append(errs, err)
+
+	for i, definition := range entityDefinitions {
+		path := entityPaths[i]
+		if definitionErrs := validateDefinition(definition, items); len(definitionErrs) > 0 {
+			errs = append(errs, withPath(path, definitionErrs)...)
+			continue
+		}
+
+		entity := definition.Entity
+		if definition.EquippedItems != nil {
+			entity.EquippedItems = &EquippedItems{
+				RightHand: items[definition.EquippedItems.RightHand],
+				LeftHand:  items[definition.EquippedItems.LeftHand],
+			}
+		}
+
+		for _, name := range append([]string{definition.InternalName}, definition.Aliases...) {
+			if _, ok := entities[name]; ok {
+				errs = append(errs, fmt.Errorf("%s: entity %q is already defined", path, name))
+				continue
+			}
+
+			entities[name] = entity
+		}
+	}
+
+	// A missing or empty directory is a broken deploy, not an empty game
+	if len(itemDefinitions) == 0 {
+		errs = append(errs, fmt.Errorf("no item definitions in %s", filepath.Join(dir, "items")))
+	}
+
+	if len(entityDefinitions) == 0 {
+		errs = append(errs, fmt.Errorf("no entity definitions in %s", filepath.Join(dir, "entities")))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+
+	return entities, items, nil
+}
+
+// readDefinitions returns the parsed files and their paths in the same order
+func readDefinitions(dir string) ([]string, []*Definition, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var errs []error
+	var paths []string
+	var definitions []*Definition
+
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+
+		definition := &Definition{}
+		if err := decoder.Decode(definition); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		paths = append(paths, path)
+		definitions = append(definitions, definition)
+	}
+
+	return paths, definitions, errors.Join(errs...)
+}
+
+func withPath(path string, errs []error) []error {
+	result := make([]error, len(errs))
+	for i, err := range errs {
+		result[i] = fmt.Errorf("%s: %w", path, err)
+	}
+
+	return result
+}
+
+// validateDefinition checks the values, items is nil when the definition is an item itself
+func validateDefinition(definition *Definition, items map[string]Entity) []error {
+	var errs []error
+
+	if definition.InternalName == "" {
+		errs = append(errs, errors.New("InternalName is required"))
+	}
+
+	if definition.MaxHealth < 0 || definition.Health < 0 || definition.Health > definition.MaxHealth {
+		errs = append(errs, fmt.Errorf("Health must be between 0 and MaxHealth %d", definition.MaxHealth))
+	}
+
+	if definition.RespawnInterval < 0 {
+		errs = append(errs, errors.New("RespawnInterval must not be negative"))
+	}
+
+	if definition.InteractChance < 0 || definition.InteractChance > 100 {
+		errs = append(errs, errors.New("InteractChance must be between 0 and 100"))
+	}
+
+	if definition.AttackMinDamage < 0 || definition.AttackMinDamage > definition.AttackDamage {
+		errs = append(errs, fmt.Errorf("AttackMinDamage must be between 0 and AttackDamage %d", definition.AttackDamage))
+	}
+
+	if definition.AttackArc < 0 || definition.AttackArc > 360 {
+		errs = append(errs, errors.New("AttackArc must be between 0 and 360"))
+	}
+
+	if definition.IsExplosive && definition.AttackRadius <= 0 {
+		errs = append(errs, errors.New("AttackRadius is required for explosive items"))
+	}
+
+	if definition.ProjectileSpeed < 0 || definition.ProjectileRange < 0 {
+		errs = append(errs, errors.New("ProjectileSpeed and ProjectileRange must not be negative"))
+	}
+
+	if definition.AgroRadius < 0 || definition.LeashDistance < 0 || definition.ChaseDistance < 0 || definition.EvadeHealRate < 0 {
+		errs = append(errs, errors.New("AgroRadius, LeashDistance, ChaseDistance and EvadeHealRate must not be negative"))
+	}
+
+	if definition.IsGuard && !definition.CanAgro {
+		errs = append(errs, errors.New("CanAgro is required for guards"))
+	}
+
+	if definition.CritChance < 0 || definition.CritChance > 100 {
+		errs = append(errs, errors.New("CritChance must be between 0 and 100"))
+	}
+
+	if definition.CritMultiplier != 0 && definition.CritMultiplier < 1 {
+		errs = append(errs, errors.New("CritMultiplier must be at least 1"))
+	}
+
+	if definition.Armor < 0 {
+		errs = append(errs, errors.New("Armor must not be negative"))
+	}
+
+	if definition.Resistance < 0 || definition.Resistance > 100 {
+		errs = append(errs, errors.New("Resistance must be between 0 and 100"))
+	}
+
+	for _, effect := range definition.Effects {
+		errs = append(errs, validateStatusEffect(effect)...)
+	}
+
+	if definition.Type != "" && definition.Type != TypeAxe && definition.Type != TypePistol && definition.Type != TypeConsumable {
+		errs = append(errs, fmt.Errorf("unknown Type %q", definition.Type))
+	}
+
+	if definition.Type == TypePistol && definition.ClipSize <= 0 {
+		errs = append(errs, errors.New("ClipSize is required for pistols"))
+	}
+
+	if definition.Collider != nil && definition.Collider.Shape != ColliderBox && definition.Collider.Shape != ColliderCapsule {
+		errs = append(errs, fmt.Errorf("unknown Collider.Shape %q", definition.Collider.Shape))
+	}
+
+	for _, surface := range definition.SpawnSurfaces {
+		if !isKnownSurface(surface) {
+			errs = append(errs, fmt.Errorf("unknown SpawnSurfaces value %q", surface))
+		}
+	}
+
+	for timeOfDay := range definition.TimeOfDayBehaviours {
+		if timeOfDay != TimeDay && timeOfDay != TimeNight {
+			errs = append(errs, fmt.Errorf("unknown TimeOfDayBehaviours key %q", timeOfDay))
+		}
+	}
+
+	if definition.EquippedItems != nil {
+		if items == nil {
+			errs = append(errs, errors.New("items can't have EquippedItems"))
+		} else {
+			if _, ok := items[definition.EquippedItems.RightHand]; definition.EquippedItems.RightHand != "" && !ok {
+				errs = append(errs, fmt.Errorf("EquippedItems.RightHand: unknown item %q", definition.EquippedItems.RightHand))
+			}
+
+			if _, ok := items[definition.EquippedItems.LeftHand]; definition.EquippedItems.LeftHand != "" && !ok {
+				errs = append(errs, fmt.Errorf("EquippedItems.LeftHand: unknown item %q", definition.EquippedItems.LeftHand))
+			}
+		}
+	}
+
+	return errs
+}
+
+func isKnownSurface(surface string) bool {
+	for _, known := range config.TerrainLayerSurfaces {
+		if known == surface {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EntityFactory returns a copy of the definition which doesn't share state with other objects
+func EntityFactory(internalName string) Entity {
+	Content.RLock()
+	defer Content.RUnlock()
+
+	return Content.entities[internalName].Clone()
+}
+
+func HasEntity(name string) bool {
+	Content.RLock()
+	defer Content.RUnlock()
+
+	_, ok := Content.entities[name]
+	return ok
+}
+
+func HasItem(name string) bool {
+	Content.RLock()
+	defer Content.RUnlock()
+
+	_, ok := Content.items[name]
+	return ok
+}
+
+func GetItem(name string) Entity {
+	Content.RLock()
+	defer Content.RUnlock()
+
+	return Content.items[name].Clone()
+}
+
+func validateStatusEffect(effect StatusEffect) []error {
+	var errs []error
+
+	if effect.Name == "" {
+		errs = append(errs, errors.New("Effects: Name is required"))
+	}
+
+	if effect.Duration <= 0 || effect.TickInterval < 0 {
+		errs = append(errs, fmt.Errorf("Effects %q: Duration must be positive and TickInterval must not be negative", effect.Name))
+	}
+
+	if effect.Chance < 0 || effect.Chance > 100 {
+		errs = append(errs, fmt.Errorf("Effects %q: Chance must be between 0 and 100", effect.Name))
+	}
+
+	if effect.SpeedModifier < 0 || effect.AttackSpeedModifier < 0 || effect.DamageTakenModifier < 0 {
+		errs = append(errs, fmt.Errorf("Effects %q: modifiers must not be negative", effect.Name))
+	}
+
+	if effect.Stacking != "" && effect.Stacking != StackingRefresh && effect.Stacking != StackingStack && effect.Stacking != StackingIgnore {
+		errs = append(errs, fmt.Errorf("Effects %q: unknown Stacking %q", effect.Name, effect.Stacking))
+	}
+
+	if effect.MaxStacks < 0 {
+		errs = append(errs, fmt.Errorf("Effects %q: MaxStacks must not be negative", effect.Name))
+	}
+
+	return errs
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadContentRejectsMissingDirectory(t *testing.T) {
+	err := LoadContent(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Fatal("missing content directory is loaded")
+	}
+
+	for _, want := range []string{"no item definitions", "no entity definitions"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q doesn't mention %q", err, want)
+		}
+	}
+}
+
+func TestLoadContentReportsUnknownEquipment(t *testing.T) {
+	dir := writeTestContent(t)
+	path := filepath.Join(dir, "entities", "guard.json")
+	data := `{ "Name": "Guard", "InternalName": "guard", "EquippedItems": { "RightHand": "sword" } }`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := LoadContent(dir)
+	if err == nil || !strings.Contains(err.Error(), `guard.json: EquippedItems.RightHand: unknown item "sword"`) {
+		t.Errorf("unknown equipment is not reported with the file: %v", err)
+	}
+}
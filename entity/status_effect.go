@@ -0,0 +1,30 @@
+package entity
+
+type EffectStacking = string
+
+const (
+	StackingRefresh EffectStacking = "refresh" // Reapplying restarts the duration
+	StackingStack   EffectStacking = "stack"   // Reapplying adds a stack up to MaxStacks and restarts the duration
+	StackingIgnore  EffectStacking = "ignore"  // Reapplying does nothing while the effect is active
+)
+
+// StatusEffect is a temporary buff or debuff, weapons apply it to the target on hit
+// and consumables to the user
+type StatusEffect struct {
+	Name     string
+	Resource string // Icon
+	IsDebuff bool
+	Chance   float32 // 0 - 100 to apply on hit, 0 always applies
+
+	Duration     float32 // in seconds
+	TickInterval float32 // in seconds, 0 doesn't tick
+	TickDamage   int32   // Damage per stack on every tick, negative heals
+
+	SpeedModifier       float32 // Walking speed multiplier per stack, 0 keeps the speed. Slows NPCs only, players move on the client
+	AttackSpeedModifier float32 // Attacks per second multiplier per stack, 0 keeps the attack speed
+	DamageTakenModifier float32 // Incoming damage multiplier per stack, 0 keeps the damage
+	IsStun              bool    // Object can't move or attack
+
+	Stacking  EffectStacking // refresh when empty
+	MaxStacks int            // 0 is a single stack
+}
@@ -1,10 +0,0 @@
-package entity
-
-var Tree = Entity{
-	Name:            "Tree",
-	InternalName:    "tree",
-	Health:          100,
-	MaxHealth:       100,
-	RespawnInterval: 60,
-	DamageSound:     "Hit/chopping-wood",
-}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"server/entity"
+	"server/proto/accountpb"
+	"server/proto/actionpb"
+	"sort"
+)
+
+func GetCharacterListPayload(characters []*accountpb.Character, maxCharacters int32) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_CharacterList{
+			CharacterList: &accountpb.CharacterList{
+				Characters:    characters,
+				MaxCharacters: maxCharacters,
+			},
+		},
+	}
+}
+
+func GetAppearanceCataloguePayload() *actionpb.Action {
+	catalogue := &accountpb.AppearanceCatalogue{Colors: entity.AppearanceColors}
+
+	for gender, slots := range entity.AppearanceCatalogue {
+		appearanceGender := &accountpb.AppearanceGender{Gender: gender}
+
+		for name, slot := range slots {
+			appearanceGender.Slots = append(appearanceGender.Slots, &accountpb.AppearanceSlot{
+				Slot:       name,
+				Recipes:    slot.Recipes,
+				IsRequired: slot.IsRequired,
+			})
+		}
+
+		sort.Slice(appearanceGender.Slots, func(i, j int) bool {
+			return appearanceGender.Slots[i].Slot < appearanceGender.Slots[j].Slot
+		})
+
+		catalogue.Genders = append(catalogue.Genders, appearanceGender)
+	}
+
+	sort.Slice(catalogue.Genders, func(i, j int) bool {
+		return catalogue.Genders[i].Gender < catalogue.Genders[j].Gender
+	})
+
+	return &actionpb.Action{
+		Action: &actionpb.Action_AppearanceCatalogue{
+			AppearanceCatalogue: catalogue,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package events
+
+import (
+	pbglobal "server/proto"
+	"server/proto/actionpb"
+	"server/proto/combatpb"
+	"server/types"
+)
+
+func GetProjectileSpawnPayload(uuid, sourceUUID, resource string, position, direction types.Vector3, speed, maxRange float32) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_ProjectileSpawn{
+			ProjectileSpawn: &combatpb.ProjectileSpawn{
+				UUID:       uuid,
+				SourceUuid: sourceUUID,
+				Resource:   resource,
+				Position: &pbglobal.Vector3M{
+					X: float32(position.X),
+					Y: float32(position.Y),
+					Z: float32(position.Z),
+				},
+				Direction: &pbglobal.Vector3M{
+					X: float32(direction.X),
+					Y: float32(direction.Y),
+					Z: float32(direction.Z),
+				},
+				Speed:    speed,
+				MaxRange: maxRange,
+			},
+		},
+	}
+}
+
+func GetProjectileImpactPayload(uuid string, position types.Vector3, targetUUID string) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_ProjectileImpact{
+			ProjectileImpact: &combatpb.ProjectileImpact{
+				UUID: uuid,
+				Position: &pbglobal.Vector3M{
+					X: float32(position.X),
+					Y: float32(position.Y),
+					Z: float32(position.Z),
+				},
+				TargetUuid: targetUUID,
+			},
+		},
+	}
+}
+
+func GetDeathPayload(uuid, killerUUID string, respawnPoints []*combatpb.RespawnPoint, respawnDelay float32) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_Death{
+			Death: &combatpb.Death{
+				UUID:          uuid,
+				KillerUuid:    killerUUID,
+				RespawnPoints: respawnPoints,
+				RespawnDelay:  respawnDelay,
+			},
+		},
+	}
+}
+
+func GetPvPStatusPayload(uuid string, isFlagged bool, combatFlagDuration float32, isInSafeZone bool) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_PvpStatus{
+			PvpStatus: &combatpb.PvPStatus{
+				UUID:               uuid,
+				IsFlagged:          isFlagged,
+				CombatFlagDuration: combatFlagDuration,
+				IsInSafeZone:       isInSafeZone,
+			},
+		},
+	}
+}
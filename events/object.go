@@ -1,6 +1,7 @@
 package events
 
 import (
+	"server/entity"
 	pbglobal "server/proto"
 	"server/proto/actionpb"
 	"server/proto/animationpb"
@@ -67,13 +68,14 @@ func GetInteractQueuePayload(object *types.GameObject) *actionpb.Action {
 func GetObjectEvent(object *types.GameObject, options *types.EventPayloadOptions) *objectpb.Object {
 
 	msg := &objectpb.Object{
-		UUID:      object.UUID,
-		Name:      object.Name,
-		Resource:  object.Resource,
-		Speed:     object.Speed,
-		IsSelf:    *&options.IsSelf,
-		Type:      object.Type,
-		Variation: object.Entity.Variation,
+		UUID:         object.UUID,
+		Name:         object.Name,
+		Resource:     object.Resource,
+		Speed:        object.Speed,
+		IsSelf:       *&options.IsSelf,
+		IsPvpFlagged: object.IsPvPFlagged(),
+		Type:         object.Type,
+		Variation:    object.Entity.Variation,
 		Position: &pbglobal.Vector3M{
 			X: float32(object.Position.X),
 			Y: float32(object.Position.Y),
@@ -108,18 +110,12 @@ func GetObjectEvent(object *types.GameObject, options *types.EventPayloadOptions
 	}
 
 	if object.HumanCharacter != nil {
-		slots := make(map[string]*objectpb.HumanSlot)
-
-		for key, slot := range object.HumanCharacter.Slots {
-			slots[key] = &objectpb.HumanSlot{
-				Recipe: slot.Recipe,
-				Color:  slot.Color,
-			}
-		}
+		msg.HumanCharacter = GetHumanCharacter(object.HumanCharacter)
+	}
 
-		msg.HumanCharacter = &objectpb.HumanCharacter{
-			Gender: object.HumanCharacter.Gender,
-			Slots:  slots,
+	if object.Effects != nil {
+		for _, effect := range object.Effects.GetAll() {
+			msg.StatusEffects = append(msg.StatusEffects, GetStatusEffect(object.UUID, effect))
 		}
 	}
 
@@ -136,3 +132,19 @@ func GetNetworkStatePayload(object *types.GameObject) *actionpb.Action {
 		},
 	}
 }
+
+func GetHumanCharacter(character *entity.HumanCharacter) *objectpb.HumanCharacter {
+	slots := make(map[string]*objectpb.HumanSlot)
+
+	for key, slot := range character.Slots {
+		slots[key] = &objectpb.HumanSlot{
+			Recipe: slot.Recipe,
+			Color:  slot.Color,
+		}
+	}
+
+	return &objectpb.HumanCharacter{
+		Gender: character.Gender,
+		Slots:  slots,
+	}
+}
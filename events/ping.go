@@ -0,0 +1,28 @@
+package events
+
+import (
+	"server/proto/actionpb"
+	"server/proto/pingpb"
+)
+
+func GetPingPayload(uuid string, timestamp int64) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_Ping{
+			Ping: &pingpb.Ping{
+				UUID:      uuid,
+				Timestamp: timestamp,
+			},
+		},
+	}
+}
+
+func GetPongPayload(uuid string, timestamp int64) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_Pong{
+			Pong: &pingpb.Pong{
+				UUID:      uuid,
+				Timestamp: timestamp,
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"server/proto/actionpb"
+	"server/proto/objectpb"
+	"server/types"
+	"time"
+)
+
+func GetStatusEffectPayload(uuid string, effect types.ActiveEffect) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_StatusEffect{
+			StatusEffect: GetStatusEffect(uuid, effect),
+		},
+	}
+}
+
+func GetStatusEffectRemovePayload(uuid, name string) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_StatusEffectRemove{
+			StatusEffectRemove: &objectpb.StatusEffectRemove{
+				UUID: uuid,
+				Name: name,
+			},
+		},
+	}
+}
+
+func GetStatusEffect(uuid string, effect types.ActiveEffect) *objectpb.StatusEffect {
+	return &objectpb.StatusEffect{
+		UUID:     uuid,
+		Name:     effect.Effect.Name,
+		Resource: effect.Effect.Resource,
+		IsDebuff: effect.Effect.IsDebuff,
+		Stacks:   int32(effect.Stacks),
+		Duration: float32(effect.GetRemaining(time.Now()).Seconds()),
+		IsStun:   effect.Effect.IsStun,
+	}
+}
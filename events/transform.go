@@ -24,7 +24,7 @@ func GetTransformRotationEventPayload(uuid string, rotation types.Vector3) *acti
 	}
 }
 
-func GetTeleportEventPayload(uuid string, position, rotation types.Vector3) *actionpb.Action {
+func GetTeleportEventPayload(uuid string, position, rotation types.Vector3, level string) *actionpb.Action {
 	return &actionpb.Action{
 		Action: &actionpb.Action_Teleport{
 			Teleport: &transformpb.Teleport{
@@ -39,6 +39,7 @@ func GetTeleportEventPayload(uuid string, position, rotation types.Vector3) *act
 					Y: float32(rotation.Y),
 					Z: float32(rotation.Z),
 				},
+				Level: level,
 			},
 		},
 	}
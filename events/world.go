@@ -0,0 +1,31 @@
+package events
+
+import (
+	"server/proto/actionpb"
+	"server/proto/worldpb"
+)
+
+func GetWorldTimePayload(hour float32, dayLength float32, timeOfDay string) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_WorldTime{
+			WorldTime: &worldpb.WorldTime{
+				Hour:      hour,
+				DayLength: dayLength,
+				TimeOfDay: timeOfDay,
+			},
+		},
+	}
+}
+
+func GetWeatherPayload(region, weatherType, previousType string, transition float32) *actionpb.Action {
+	return &actionpb.Action{
+		Action: &actionpb.Action_Weather{
+			Weather: &worldpb.Weather{
+				Region:       region,
+				Type:         weatherType,
+				PreviousType: previousType,
+				Transition:   transition,
+			},
+		},
+	}
+}
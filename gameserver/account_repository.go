@@ -0,0 +1,219 @@
+package gameserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"server/config"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const MIN_PASSWORD_LENGTH = 8
+
+var ErrAccountExists = errors.New("account already exists")
+
+// AccountRecord holds the login credentials, the characters are in the player repository
+type AccountRecord struct {
+	Account      string
+	PasswordHash []byte // bcrypt
+	CreatedAt    time.Time
+}
+
+type AccountRepository interface {
+	Load(account string) (*AccountRecord, error) // Returns nil record for unknown account
+	Create(record *AccountRecord) error          // Returns ErrAccountExists when the account is taken
+	Save(record *AccountRecord) error            // Creates or replaces the account
+}
+
+// FileAccountRepository keeps every account in its own JSON file
+type FileAccountRepository struct {
+	sync.Mutex
+	dir string
+}
+
+var Accounts AccountRepository = NewFileAccountRepository(config.AccountsDir)
+
+func NewFileAccountRepository(dir string) *FileAccountRepository {
+	return &FileAccountRepository{dir: dir}
+}
+
+func (r *FileAccountRepository) getPath(account string) string {
+	return filepath.Join(r.dir, account+".json")
+}
+
+func (r *FileAccountRepository) Load(account string) (*AccountRecord, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	data, err := os.ReadFile(r.getPath(account))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &AccountRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Create writes the account file only if it doesn't exist yet, two clients can't register the same name
+func (r *FileAccountRepository) Create(record *AccountRecord) error {
+	r.Lock()
+	defer r.Unlock()
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(r.getPath(record.Account), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if errors.Is(err, os.ErrExist) {
+		return ErrAccountExists
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+
+	return file.Close()
+}
+
+// Save replaces the account file atomically, used by the administrator to set a password
+func (r *FileAccountRepository) Save(record *AccountRecord) error {
+	r.Lock()
+	defer r.Unlock()
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(r.dir, record.Account+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), r.getPath(record.Account))
+}
+
+// authenticate checks the password of the account, unknown accounts are registered with it
+func authenticate(account, password string) error {
+	if !accountNameRegexp.MatchString(account) {
+		return errors.New("invalid account name")
+	}
+
+	record, err := Accounts.Load(account)
+	if err != nil {
+		return err
+	}
+
+	if record != nil {
+		if bcrypt.CompareHashAndPassword(record.PasswordHash, []byte(password)) != nil {
+			return errors.New("wrong account name or password")
+		}
+		return nil
+	}
+
+	return registerAccount(account, password)
+}
+
+func registerAccount(account, password string) error {
+	if !config.AccountRegistration {
+		return errors.New("wrong account name or password")
+	}
+
+	if len(password) < MIN_PASSWORD_LENGTH {
+		return errors.New("password is too short")
+	}
+
+	// Characters saved before accounts had passwords are only claimed when the server allows it
+	characters, err := Players.List(account)
+	if err != nil {
+		return err
+	}
+	if len(characters) > 0 && !config.ClaimLegacyAccounts {
+		return errors.New("account has no password, ask an administrator")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	if err := Accounts.Create(&AccountRecord{Account: account, PasswordHash: hash, CreatedAt: time.Now()}); err != nil {
+		if errors.Is(err, ErrAccountExists) {
+			return errors.New("wrong account name or password")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SetAccountPassword sets the password of an existing or legacy account, the administrator unlocks
+// accounts with characters saved before passwords existed with it
+func SetAccountPassword(account, password string) error {
+	if !accountNameRegexp.MatchString(account) {
+		return errors.New("invalid account name")
+	}
+
+	if len(password) < MIN_PASSWORD_LENGTH {
+		return errors.New("password is too short")
+	}
+
+	record, err := Accounts.Load(account)
+	if err != nil {
+		return err
+	}
+
+	if record == nil {
+		record = &AccountRecord{Account: account, CreatedAt: time.Now()}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	record.PasswordHash = hash
+
+	if err := Accounts.Save(record); err != nil {
+		return err
+	}
+
+	fmt.Println("Password set for account", account)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package gameserver
+
+import "testing"
+
+func TestSetAccountPasswordUnlocksLegacyAccount(t *testing.T) {
+	accounts, players := Accounts, Players
+	t.Cleanup(func() { Accounts, Players = accounts, players })
+
+	Accounts = NewFileAccountRepository(t.TempDir())
+	Players = NewFilePlayerRepository(t.TempDir())
+
+	if err := Players.Save(&PlayerRecord{Account: "veteran", Name: "Hero"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := authenticate("veteran", "password1"); err == nil {
+		t.Fatal("legacy account is claimed by the first login")
+	}
+
+	if err := SetAccountPassword("veteran", "short"); err == nil {
+		t.Error("short password is accepted")
+	}
+
+	if err := SetAccountPassword("veteran", "password1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := authenticate("veteran", "password1"); err != nil {
+		t.Errorf("login with the set password: %v", err)
+	}
+	if err := authenticate("veteran", "password2"); err == nil {
+		t.Error("login with a wrong password succeeded")
+	}
+
+	// Resetting replaces the password
+	if err := SetAccountPassword("veteran", "password2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticate("veteran", "password1"); err == nil {
+		t.Error("old password still works after the reset")
+	}
+}
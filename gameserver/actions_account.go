@@ -0,0 +1,152 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"server/config"
+	"server/entity"
+	"server/events"
+	"server/proto/accountpb"
+	"server/proto/objectpb"
+	"server/types"
+)
+
+// Account and character names are used as file names
+var accountNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{3,32}$`)
+
+// ActionLogin binds the connection to an account and opens the character select
+func ActionLogin(client *types.TCPClient, login *accountpb.Login) {
+	if client.Account != "" {
+		return
+	}
+
+	if err := authenticate(login.Account, login.Password); err != nil {
+		fmt.Printf("Login to %s failed: %v\n", login.Account, err)
+		sendAccountError(client, err)
+		return
+	}
+
+	if err := TCPState.setAccount(client, login.Account); err != nil {
+		sendAccountError(client, err)
+		return
+	}
+
+	TCPState.sendToClient(client.UUID, events.GetAppearanceCataloguePayload())
+	sendCharacterList(client)
+}
+
+func ActionCreateCharacter(client *types.TCPClient, create *accountpb.CreateCharacter) {
+	if client.Account == "" || client.Character != "" {
+		return
+	}
+
+	humanCharacter := getHumanCharacter(create.HumanCharacter)
+	if err := validateNewCharacter(client.Account, create.Name, humanCharacter); err != nil {
+		sendAccountError(client, err)
+		return
+	}
+
+	if err := Players.Save(newPlayerRecord(client.Account, create.Name, *humanCharacter)); err != nil {
+		fmt.Printf("Error creating character %s/%s: %v\n", client.Account, create.Name, err)
+		sendAccountError(client, errors.New("character can not be created"))
+		return
+	}
+
+	fmt.Println("Character created", client.Account, create.Name)
+
+	sendCharacterList(client)
+}
+
+// ActionSelectCharacter enters the world with the chosen character
+func ActionSelectCharacter(client *types.TCPClient, selectCharacter *accountpb.SelectCharacter) {
+	if client.Account == "" {
+		return
+	}
+
+	if !accountNameRegexp.MatchString(selectCharacter.Name) {
+		sendAccountError(client, errors.New("invalid character name"))
+		return
+	}
+
+	record, err := Players.Load(client.Account, selectCharacter.Name)
+	if err != nil {
+		fmt.Printf("Error loading character %s/%s: %v\n", client.Account, selectCharacter.Name, err)
+		sendAccountError(client, errors.New("character can not be loaded"))
+		return
+	}
+
+	if record == nil {
+		sendAccountError(client, errors.New("character not found"))
+		return
+	}
+
+	if err := TCPState.setCharacter(client, record.Name); err != nil {
+		sendAccountError(client, err)
+		return
+	}
+
+	TCPState.spawnPlayer(client.UUID, record)
+}
+
+func validateNewCharacter(account, name string, humanCharacter *entity.HumanCharacter) error {
+	if !accountNameRegexp.MatchString(name) {
+		return errors.New("invalid character name")
+	}
+
+	records, err := Players.List(account)
+	if err != nil {
+		return err
+	}
+
+	if len(records) >= config.MaxCharacters {
+		return errors.New("characters limit reached")
+	}
+
+	for _, record := range records {
+		if record.Name == name {
+			return errors.New("character name is already taken")
+		}
+	}
+
+	return entity.ValidateHumanCharacter(humanCharacter)
+}
+
+func getHumanCharacter(msg *objectpb.HumanCharacter) *entity.HumanCharacter {
+	if msg == nil {
+		return nil
+	}
+
+	slots := make(map[string]entity.HumanSlot)
+	for key, slot := range msg.Slots {
+		if slot == nil {
+			continue
+		}
+		slots[key] = entity.HumanSlot{Recipe: slot.Recipe, Color: slot.Color}
+	}
+
+	return &entity.HumanCharacter{Gender: msg.Gender, Slots: slots}
+}
+
+func sendCharacterList(client *types.TCPClient) {
+	records, err := Players.List(client.Account)
+	if err != nil {
+		fmt.Printf("Error listing characters of %s: %v\n", client.Account, err)
+		return
+	}
+
+	characters := make([]*accountpb.Character, 0, len(records))
+	for _, record := range records {
+		characters = append(characters, &accountpb.Character{
+			Name:           record.Name,
+			HumanCharacter: events.GetHumanCharacter(&record.HumanCharacter),
+			Level:          record.WorldName,
+		})
+	}
+
+	TCPState.sendToClient(client.UUID, events.GetCharacterListPayload(characters, config.MaxCharacters))
+}
+
+func sendAccountError(client *types.TCPClient, err error) {
+	TCPState.sendToClient(client.UUID, events.GetMessageEventPayload("", client.UUID, err.Error()))
+}
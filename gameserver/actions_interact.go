@@ -3,11 +3,14 @@ package gameserver
 import (
 	"fmt"
 	"math"
-	"server/entity"
+	"server/config"
 	"server/proto/interactpb"
 	"server/types"
+	"time"
 )
 
+const CONSUMABLE_PICKUP_RADIUS float64 = 1.5
+
 func ActionInteract(world *World, client *types.TCPClient, action *interactpb.Interact) {
 
 	source, err := world.getObject(client.UUID)
@@ -16,6 +19,15 @@ func ActionInteract(world *World, client *types.TCPClient, action *interactpb.In
 		return
 	}
 
+	// Consumables on the ground are used on pick up
+	if consumable, dist := world.findClosestConsumable(source); consumable != nil && dist <= CONSUMABLE_PICKUP_RADIUS {
+		if world.removeObject(consumable.UUID) {
+			DestroyObjectChannel <- &types.DestroyObject{Object: consumable}
+			world.useConsumable(source, consumable.Entity)
+		}
+		return
+	}
+
 	closes, dist := world.findClosestMapObjectByKind(source, types.ObjectKindTree)
 	fmt.Println("Closest object: ", dist)
 	if dist <= 1.5 && !closes.IsDead() {
@@ -46,7 +58,7 @@ func ActionInteract(world *World, client *types.TCPClient, action *interactpb.In
 		}
 
 		world.updateObjectVariation(closes, 1)
-		closes.ScheduleRespawn()
+		world.scheduleResourceRespawn(closes)
 
 	}
 }
@@ -66,7 +78,13 @@ func ActionInteractWith(world *World, client *types.TCPClient, action *interactp
 	}
 
 	attackRange := source.GetAttackRange()
-	dist := distance(source.Position, target.Position)
+	if attackRange == nil {
+		return
+	}
+
+	// Validate against the target where the attacker saw it on screen
+	targetPosition := target.GetPositionAt(time.Now().Add(-getLagCompensation(client.UUID)))
+	dist := math.Min(distance(source.Position, target.Position), distance(source.Position, targetPosition))
 
 	if dist > *attackRange {
 		fmt.Println("Target is out of range")
@@ -74,34 +92,25 @@ func ActionInteractWith(world *World, client *types.TCPClient, action *interactp
 
 	}
 
-	maxDamage := source.GetAttackMaxDamage()
-	if maxDamage == nil || *maxDamage == 0 {
-		fmt.Println("Error getting max damage")
+	if source.IsRangedAttack() && !world.canSee(source, target) && !world.hasLineOfSight(eyePosition(source.Position), eyePosition(targetPosition), source.UUID, target.UUID) {
+		fmt.Println("Target is not visible")
 		return
 	}
 
-	damage := *maxDamage
-	isCrit := false
-
-	if damage == *maxDamage {
-		isCrit = true
-	}
-
-	target.TakeDamage(damage)
-
-	lookAtRotation := source.LookAt(target)
-	UpdateTransformRotationChannel <- &types.TransformRotation{Object: source, Rotation: lookAtRotation}
-
 	if target.IsDead() {
-		// loot
-		world.dropItemOnGround(entity.Pistol, types.Vector3{X: target.Position.X, Y: source.Position.Y, Z: target.Position.Z})
-		objectTarget := &target
-		world.hideObject(target.UUID)
+		return
+	}
 
-		DestroyObjectChannel <- &types.DestroyObject{Object: *objectTarget}
+	// Requests during the cooldown or reload are dropped
+	if !world.startAttack(source, target) {
 		return
 	}
 
-	DamageChannel <- &types.Damage{Object: target, Amount: damage, IsCrit: isCrit, HealthCurrent: int32(target.Entity.Health), HealthMax: int32(target.Entity.MaxHealth)}
+	lookAtRotation := source.LookAt(target)
+	UpdateTransformRotationChannel <- &types.TransformRotation{Object: source, Rotation: lookAtRotation}
+}
 
+// getLagCompensation returns how far back the client saw the world: half the round trip plus the interpolation delay
+func getLagCompensation(uuid string) time.Duration {
+	return min(UDPState.getRTT(uuid)/2+config.ClientInterpolationDelay, config.MaxLagCompensation)
 }
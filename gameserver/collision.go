@@ -0,0 +1,76 @@
+package gameserver
+
+import (
+	"server/types"
+)
+
+const (
+	PLAYER_RADIUS float64 = 0.3
+	NPC_RADIUS    float64 = 0.4
+	LOOT_RADIUS   float64 = 0.3
+)
+
+func (w *World) getCollidersNear(position types.Vector3) []*types.Collider {
+	box := types.Box{
+		Min: types.Vector3f{position.X - MAX_OBSTACLE_SIZE, position.Y - MAX_OBSTACLE_SIZE, position.Z - MAX_OBSTACLE_SIZE},
+		Max: types.Vector3f{position.X + MAX_OBSTACLE_SIZE, position.Y + MAX_OBSTACLE_SIZE, position.Z + MAX_OBSTACLE_SIZE},
+	}
+
+	colliders := make([]*types.Collider, 0)
+	for _, obj := range w.elementsIn(box) {
+		if collider := obj.GetCollider(); collider != nil {
+			colliders = append(colliders, collider)
+		}
+	}
+
+	return colliders
+}
+
+func (w *World) isInsideCollider(position types.Vector3, radius float64) bool {
+	for _, collider := range w.getCollidersNear(position) {
+		if _, ok := collider.Penetration(position, radius); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validatePlayerPosition rejects moves into static colliders and pulls a player reported under the ground
+// back to the surface, returns true when the position is corrected
+func (w *World) validatePlayerPosition(obj *types.GameObject, prevPosition types.Vector3) bool {
+	isCorrected := false
+
+	// Allow moving out when the player is already stuck (e.g. a tree grew back on top of it)
+	if w.isInsideCollider(obj.Position, PLAYER_RADIUS) && !w.isInsideCollider(prevPosition, PLAYER_RADIUS) {
+		obj.Position = prevPosition
+		isCorrected = true
+	}
+
+	ground := w.getGroundHeight(obj.Position)
+	if obj.Position.Y < ground-MAX_BELOW_GROUND {
+		obj.Position.Y = ground
+		isCorrected = true
+	}
+
+	return isCorrected
+}
+
+// pushOutOfColliders moves the position out of static colliders on the X/Z plane
+func (w *World) pushOutOfColliders(position types.Vector3, radius float64) types.Vector3 {
+	for _, collider := range w.getCollidersNear(position) {
+		if push, ok := collider.Penetration(position, radius); ok {
+			position.X += push.X
+			position.Z += push.Z
+		}
+	}
+
+	return position
+}
+
+// pushPathNodeOut keeps navigation grid nodes (X, Y, Z) from clipping into static objects
+func (w *World) pushPathNodeOut(node [3]float64) [3]float64 {
+	position := w.pushOutOfColliders(types.Vector3{X: node[0], Y: node[1], Z: node[2]}, NPC_RADIUS)
+
+	return [3]float64{position.X, node[1], position.Z}
+}
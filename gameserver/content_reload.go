@@ -0,0 +1,204 @@
+package gameserver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"server/config"
+	"server/entity"
+	"server/types"
+	"time"
+)
+
+// Zones which don't apply a live content update in time are skipped in the updated count
+const CONTENT_UPDATE_TIMEOUT = 5 * time.Second
+
+// ReloadContent re-reads the definitions, new spawns use them right away and live objects only when asked
+func ReloadContent(applyToLive bool) (*entity.ContentDiff, int, error) {
+	diff, err := entity.ReloadContent(config.ContentDir)
+	if err != nil {
+		fmt.Printf("Content reload failed:\n%v\n", err)
+		return nil, 0, err
+	}
+
+	updated := 0
+	if applyToLive && !diff.IsEmpty() {
+		updated = applyContentToLive(diff)
+	}
+
+	fmt.Printf("Content reloaded, entities +%d -%d ~%d, items +%d -%d ~%d, live objects updated: %d\n",
+		len(diff.AddedEntities), len(diff.RemovedEntities), len(diff.ChangedEntities),
+		len(diff.AddedItems), len(diff.RemovedItems), len(diff.ChangedItems), updated)
+
+	return diff, updated, nil
+}
+
+type contentUpdate struct {
+	changedEntities  map[string]bool
+	changedEquipment map[string]bool // Entities whose default equipment is replaced
+	changedItems     map[string]bool
+	updated          chan int
+}
+
+// applyContentToLive hands the changes to every zone and waits until the zone ticks apply them,
+// the objects are only modified by the goroutine of their zone
+func applyContentToLive(diff *entity.ContentDiff) int {
+	update := &contentUpdate{
+		changedEntities:  map[string]bool{},
+		changedEquipment: map[string]bool{},
+		changedItems:     map[string]bool{},
+	}
+
+	for _, change := range diff.ChangedEntities {
+		update.changedEntities[change.Name] = true
+
+		// Item changes show up in the equipment too, only a different set of items replaces it
+		for _, field := range change.Fields {
+			if field.Field == "EquippedItems" && field.Old != field.New {
+				update.changedEquipment[change.Name] = true
+			}
+		}
+	}
+
+	for _, change := range diff.ChangedItems {
+		update.changedItems[change.Name] = true
+	}
+
+	var zones []*Zone
+	for _, world := range Worlds.getAll() {
+		world.RLock()
+		zones = append(zones, world.zones...)
+		world.RUnlock()
+	}
+
+	update.updated = make(chan int, len(zones))
+	timeout := time.After(CONTENT_UPDATE_TIMEOUT)
+
+	pending := 0
+	for _, zone := range zones {
+		select {
+		case zone.contentUpdates <- update:
+			pending++
+		case <-zone.world.stop:
+		case <-timeout:
+			fmt.Println("Live content update timed out in zone", zone.world.Name, zone.Name)
+		}
+	}
+
+	updated := 0
+	for ; pending > 0; pending-- {
+		select {
+		case count := <-update.updated:
+			updated += count
+		case <-timeout:
+			fmt.Printf("Live content update timed out, %d zones are not done yet\n", pending)
+			return updated
+		}
+	}
+
+	return updated
+}
+
+// contentTick applies the reloaded definitions to the objects of the zone
+func (z *Zone) contentTick() {
+	for {
+		select {
+		case update := <-z.contentUpdates:
+			updated := 0
+			for _, obj := range z.getObjects() {
+				if refreshEntity(obj, update) {
+					updated++
+				}
+			}
+			update.updated <- updated
+		default:
+			return
+		}
+	}
+}
+
+// refreshEntity replaces the template values of the object and keeps its state: health, clip and reload
+func refreshEntity(obj *types.GameObject, update *contentUpdate) bool {
+	isUpdated := false
+
+	if obj.Entity.InternalName != "" && update.changedEntities[obj.Entity.InternalName] {
+		template := entity.EntityFactory(obj.Entity.InternalName)
+		template.Health = min(obj.Entity.Health, template.MaxHealth)
+
+		// The template equipment is already up to date with the reloaded items
+		if update.changedEquipment[obj.Entity.InternalName] {
+			obj.Entity = template
+			return true
+		}
+
+		template.EquippedItems = obj.Entity.EquippedItems
+		obj.Entity = template
+		isUpdated = true
+	}
+
+	if obj.Entity.EquippedItems == nil {
+		return isUpdated
+	}
+
+	rightHand := obj.Entity.EquippedItems.RightHand
+	leftHand := obj.Entity.EquippedItems.LeftHand
+
+	if !update.changedItems[rightHand.InternalName] && !update.changedItems[leftHand.InternalName] {
+		return isUpdated
+	}
+
+	if update.changedItems[rightHand.InternalName] {
+		rightHand = refreshItem(rightHand)
+	}
+
+	if update.changedItems[leftHand.InternalName] {
+		leftHand = refreshItem(leftHand)
+	}
+
+	obj.Entity.EquippedItems = &entity.EquippedItems{RightHand: rightHand, LeftHand: leftHand}
+
+	return true
+}
+
+func refreshItem(item entity.Entity) entity.Entity {
+	template := entity.GetItem(item.InternalName)
+	template.Health = min(item.Health, template.MaxHealth)
+	template.Clip = min(item.Clip, template.ClipSize)
+	template.ReloadFinishTime = item.ReloadFinishTime
+
+	return template
+}
+
+// ProcessContentWatch reloads the content when a definition file changes, disabled with zero interval
+func ProcessContentWatch() {
+	if config.ContentWatchInterval == 0 {
+		return
+	}
+
+	lastModified := getContentModTime()
+
+	ticker := time.NewTicker(config.ContentWatchInterval)
+	for range ticker.C {
+		modified := getContentModTime()
+		if !modified.After(lastModified) {
+			continue
+		}
+
+		lastModified = modified
+		ReloadContent(config.ContentReloadLive)
+	}
+}
+
+func getContentModTime() time.Time {
+	var latest time.Time
+
+	files, _ := filepath.Glob(filepath.Join(config.ContentDir, "*", "*.json"))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+
+	return latest
+}
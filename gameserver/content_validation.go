@@ -0,0 +1,41 @@
+package gameserver
+
+import (
+	"fmt"
+	"server/entity"
+	"server/types"
+)
+
+// validateServerItems reports the items the server hands out which are missing from the content
+func validateServerItems() []error {
+	var errs []error
+	for _, item := range append([]string{DEFAULT_PLAYER_WEAPON}, npcLoot...) {
+		if !entity.HasItem(item) {
+			errs = append(errs, fmt.Errorf("item %q used by the server is not defined", item))
+		}
+	}
+
+	return errs
+}
+
+// validateLevelContent reports the level NPCs and resources missing from the content,
+// they would silently become empty entities
+func validateLevelContent(levelName string, level *LevelData) []error {
+	var errs []error
+
+	reported := map[string]bool{}
+	for _, object := range level.Objects {
+		if object.kind != types.ObjectKindNPC && object.kind != types.ObjectKindTree && object.kind != types.ObjectKindOre {
+			continue
+		}
+
+		if reported[object.name] || entity.HasEntity(object.name) {
+			continue
+		}
+
+		reported[object.name] = true
+		errs = append(errs, fmt.Errorf("level %s: object %q is not defined", levelName, object.name))
+	}
+
+	return errs
+}
@@ -1,7 +1,10 @@
 package gameserver
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"server/config"
 	"server/entity"
 	"server/types"
 	"time"
@@ -9,44 +12,62 @@ import (
 
 var W *World
 
+// Combat rolls damage of player and NPC attacks
+var Combat = types.NewCombatCalculator(time.Now().UnixNano())
+
 func StartGameServer() {
 	fmt.Println("Starting game server")
 	ch := make(chan int)
 
-	level, _ := LoadLevel()
+	if repository, ok := Players.(*FilePlayerRepository); ok {
+		if err := repository.MigrateLegacyFiles(); err != nil {
+			fmt.Printf("Error migrating players:\n%v\n", err)
+		}
+	}
 
-	W = NewWorld(float64(level.TerrainData.size[0]))
+	contentErrs := validateServerItems()
 
-	for _, teleport := range level.Teleports {
-		W.teleports = append(W.teleports, &teleport)
-	}
+	for _, levelConfig := range config.Levels {
+		if levelConfig.IsInstance {
+			continue
+		}
 
-	for _, object := range level.Objects {
+		level, err := LoadLevel(levelConfig.FilePath)
+		if err != nil {
+			fmt.Printf("Error loading level %s: %v\n", levelConfig.Name, err)
+			continue
+		}
 
-		if object.isNPC() {
-			LoadNPC(object)
+		if errs := validateLevelContent(levelConfig.Name, level); len(errs) > 0 {
+			contentErrs = append(contentErrs, errs...)
 			continue
 		}
 
-		uuid := fmt.Sprintf("object-%d", object.uid)
+		world := NewLevelWorld(levelConfig.Name, levelConfig.Name, level)
+		if err := world.restoreSnapshot(); err != nil {
+			fmt.Printf("Error restoring snapshot of %s: %v\n", levelConfig.Name, err)
+		}
+		Worlds.add(world)
 
-		levelObject := &types.GameObject{
-			Entity:         entity.EntityFactory(object.name),
-			Kind:           object.kind,
-			UUID:           uuid,
-			VariationIndex: object.variationIndex,
-			Position:       types.Vector3{X: float64(object.position[0]), Y: float64(object.position[1]), Z: float64(object.position[2])},
-			Rotation:       types.Vector3{X: float64(object.rotation[0]), Y: float64(object.rotation[1]), Z: float64(object.rotation[2])},
-			Type:           types.ObjectTypeVariantMapObject,
+		// Players spawn in the first level
+		if W == nil {
+			W = world
 		}
+	}
 
-		W.addObject(levelObject)
+	if len(contentErrs) > 0 {
+		fmt.Printf("Invalid content in %s:\n%v\n", config.ContentDir, errors.Join(contentErrs...))
+		os.Exit(1)
 	}
 
 	go StartUDPServer()
 	go StartTCPServer()
 
-	go globalTicker()
+	for _, levelConfig := range config.Levels {
+		if world := Worlds.get(levelConfig.Name); world != nil {
+			world.startZones()
+		}
+	}
 
 	go ProcessMovementUpdates()
 	go ProcessTeleportObjectUpdates()
@@ -55,25 +76,67 @@ func StartGameServer() {
 	go ProcessTransformRotationUpdates()
 	go ProcessAnimationUpdates()
 	go ProcessDamage()
+	go ProcessStatusEffects()
 	go ProcessObjectDestroy()
 	go ProcessSpawnObject()
 	go ProcessInteractQueue()
+	go ProcessInstancesTeardown()
+	go ProcessWorldTimeBroadcast()
+	go ProcessWorldSnapshots()
+	go ProcessPlayersSave()
+	go ProcessContentWatch()
+	go ProcessRTTProbes()
 
 	ch <- 1
 
 }
 
-func globalTicker() {
-
-	// Run global ticker 25 times per second
-	ticker := time.NewTicker(40 * time.Millisecond)
-	for range ticker.C {
-		W.npcRespawnTick()
-		W.npcWalkTick()
-		W.npcAttackTick()
-		W.mapObjectVariationTick()
-		W.mapObjectDestroyTick()
-		// currentTime := time.Now()
-		// fmt.Println("Tick: ", currentTime)
+// NewLevelWorld creates a world instance populated with the level objects and NPCs
+func NewLevelWorld(name, levelName string, level *LevelData) *World {
+	world := NewWorld(name, level.getSize())
+	world.Level = levelName
+	world.terrain = NewTerrain(level.TerrainData)
+
+	for i := range level.Teleports {
+		world.teleports = append(world.teleports, &level.Teleports[i])
+	}
+
+	for i := range level.Regions {
+		world.regions = append(world.regions, &level.Regions[i])
 	}
+
+	for _, rect := range config.SafeZones[levelName] {
+		world.regions = append(world.regions, &LevelRegion{Name: rect.Name, Type: RegionSafe, MinX: rect.MinX, MinZ: rect.MinZ, MaxX: rect.MaxX, MaxZ: rect.MaxZ})
+	}
+
+	for _, object := range level.Objects {
+
+		// Regions are areas, not objects, they are in world.regions
+		if object.kind == types.ObjectKindRegion {
+			continue
+		}
+
+		if object.isNPC() {
+			LoadNPC(world, object)
+			continue
+		}
+
+		uuid := fmt.Sprintf("object-%d", object.uid)
+
+		levelObject := &types.GameObject{
+			Entity:         entity.EntityFactory(object.name),
+			Kind:           object.kind,
+			UUID:           uuid,
+			VariationIndex: object.variationIndex,
+			Position:       types.Vector3{X: float64(object.position[0]), Y: float64(object.position[1]), Z: float64(object.position[2])},
+			Rotation:       types.Vector3{X: float64(object.rotation[0]), Y: float64(object.rotation[1]), Z: float64(object.rotation[2])},
+			Scale:          types.Vector3{X: float64(object.scale[0]), Y: float64(object.scale[1]), Z: float64(object.scale[2])},
+			Orientation:    types.Quaternion{X: float64(object.rotation[0]), Y: float64(object.rotation[1]), Z: float64(object.rotation[2]), W: float64(object.rotation[3])},
+			Type:           types.ObjectTypeVariantMapObject,
+		}
+
+		world.addObject(levelObject)
+	}
+
+	return world
 }
@@ -0,0 +1,219 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+	"server/config"
+	"server/events"
+	"server/types"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	instanceTargetPrefix = "instance:"      // "instance:<level>" enters a private copy of the level
+	instanceLeaveTarget  = "instance:leave" // returns to the overworld teleport the party came from
+)
+
+// Instance is a private copy of a level for a group of players
+type Instance struct {
+	World          *World
+	Members        map[string]bool
+	ReturnWorld    string
+	ReturnTeleport string
+	EmptySince     *time.Time
+	Entering       int // Teleports into the instance in progress, it is not torn down meanwhile
+}
+
+type InstancesState struct {
+	sync.Mutex
+	instances map[string]*Instance
+	levels    map[string]*LevelData // Parsed once, every instance creates its own objects
+	nextID    int
+}
+
+var Instances = &InstancesState{
+	instances: map[string]*Instance{},
+	levels:    map[string]*LevelData{},
+}
+
+func (s *InstancesState) handleTeleport(from *World, obj *types.GameObject, entrance *LevelTeleport) {
+	if entrance.Target == instanceLeaveTarget {
+		s.leave(from, obj)
+		return
+	}
+
+	s.enter(from, obj, strings.TrimPrefix(entrance.Target, instanceTargetPrefix), entrance)
+}
+
+// enter moves the player and its group standing in the entrance to their instance, spinning up a new one if needed
+func (s *InstancesState) enter(from *World, obj *types.GameObject, levelName string, entrance *LevelTeleport) {
+	s.Lock()
+
+	instance := s.findMemberInstance(obj.UUID, levelName)
+	if instance == nil {
+		var err error
+		instance, err = s.create(levelName)
+		if err != nil {
+			s.Unlock()
+			fmt.Println("Instance not created: ", err)
+			TCPState.sendToClient(obj.UUID, events.GetMessageEventPayload("", obj.UUID, err.Error()))
+			return
+		}
+
+		for _, player := range from.getPlayersByPosition(entrance.Position, TELEPORT_TRIGGER_RADIUS) {
+			if s.findMemberInstance(player.UUID, levelName) == nil {
+				instance.Members[player.UUID] = true
+			}
+		}
+	}
+
+	instance.Members[obj.UUID] = true
+	instance.ReturnWorld = from.Name
+	instance.ReturnTeleport = entrance.Name
+	instance.Entering++
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		instance.Entering--
+		instance.EmptySince = nil
+		s.Unlock()
+	}()
+
+	teleport := instance.World.getTeleport("main")
+	if teleport == nil {
+		fmt.Println("Teleport not found in instance ", instance.World.Name)
+		return
+	}
+
+	group := []*types.GameObject{obj}
+	for _, player := range from.getPlayersByPosition(entrance.Position, TELEPORT_TRIGGER_RADIUS) {
+		if player.UUID != obj.UUID && instance.Members[player.UUID] {
+			group = append(group, player)
+		}
+	}
+
+	for _, player := range group {
+		from.teleportObject(player, instance.World, teleport)
+	}
+}
+
+func (s *InstancesState) leave(from *World, obj *types.GameObject) {
+	s.Lock()
+	instance := s.instances[from.Name]
+	s.Unlock()
+
+	if instance == nil {
+		return
+	}
+
+	world := Worlds.get(instance.ReturnWorld)
+	if world == nil {
+		world = W
+	}
+
+	teleport := world.getTeleport(instance.ReturnTeleport)
+	if teleport == nil {
+		teleport = world.getTeleport("main")
+	}
+
+	from.teleportObject(obj, world, teleport)
+}
+
+func (s *InstancesState) isInstance(worldName string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.instances[worldName]
+	return ok
+}
+
+func (s *InstancesState) findMemberInstance(uuid, levelName string) *Instance {
+	for _, instance := range s.instances {
+		if instance.World.Level == levelName && instance.Members[uuid] {
+			return instance
+		}
+	}
+
+	return nil
+}
+
+func (s *InstancesState) create(levelName string) (*Instance, error) {
+	if len(s.instances) >= config.MaxInstances {
+		return nil, errors.New("all instances are busy, try again later")
+	}
+
+	levelConfig := config.GetLevel(levelName)
+	if levelConfig == nil || !levelConfig.IsInstance {
+		return nil, fmt.Errorf("instance level %s not found", levelName)
+	}
+
+	level, ok := s.levels[levelName]
+	if !ok {
+		var err error
+		level, err = LoadLevel(levelConfig.FilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if errs := validateLevelContent(levelName, level); len(errs) > 0 {
+			fmt.Printf("Invalid content for instance %s:\n%v\n", levelName, errors.Join(errs...))
+			return nil, fmt.Errorf("instance level %s is broken", levelName)
+		}
+		s.levels[levelName] = level
+	}
+
+	s.nextID++
+	name := fmt.Sprintf("%s#%d", levelName, s.nextID)
+
+	instance := &Instance{
+		World:   NewLevelWorld(name, levelName, level),
+		Members: map[string]bool{},
+	}
+
+	s.instances[name] = instance
+	Worlds.add(instance.World)
+	instance.World.startZones()
+
+	fmt.Println("Instance created: ", name)
+
+	return instance, nil
+}
+
+// teardownTick destroys instances which have been empty longer than the timeout
+func (s *InstancesState) teardownTick() {
+	s.Lock()
+	defer s.Unlock()
+
+	for name, instance := range s.instances {
+		if instance.Entering > 0 || instance.World.getPlayersCount() > 0 {
+			instance.EmptySince = nil
+			continue
+		}
+
+		if instance.EmptySince == nil {
+			now := time.Now()
+			instance.EmptySince = &now
+			continue
+		}
+
+		if time.Since(*instance.EmptySince) < config.InstanceEmptyTimeout {
+			continue
+		}
+
+		instance.World.stopZones()
+		Worlds.remove(name)
+		delete(s.instances, name)
+
+		fmt.Println("Instance destroyed: ", name)
+	}
+}
+
+func ProcessInstancesTeardown() {
+	ticker := time.NewTicker(5 * time.Second)
+	for range ticker.C {
+		Instances.teardownTick()
+	}
+}
@@ -6,11 +6,12 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io/ioutil"
-	"server/config"
 	"server/types"
 	"strings"
 )
 
+const DEFAULT_LEVEL_SIZE float64 = 1000
+
 type Object struct {
 	uid                      int32
 	name                     string
@@ -35,6 +36,7 @@ type LevelData struct {
 	ObjectsCount int32
 	Objects      []Object
 	Teleports    []LevelTeleport
+	Regions      []LevelRegion
 }
 
 type LevelTeleport struct {
@@ -44,6 +46,27 @@ type LevelTeleport struct {
 	Rotation types.Vector3
 }
 
+type RegionType = string
+
+const (
+	RegionSafe RegionType = "safe" // No damage, guards attack PvP flagged players
+	RegionPvP  RegionType = "pvp"  // Players fight each other in the zone PvP mode
+)
+
+// LevelRegion is an area on X/Z, the level editor exports it as a box scaled to its size
+type LevelRegion struct {
+	Name string
+	Type RegionType
+	MinX float64
+	MinZ float64
+	MaxX float64
+	MaxZ float64
+}
+
+func (r *LevelRegion) contains(position types.Vector3) bool {
+	return position.X >= r.MinX && position.X <= r.MaxX && position.Z >= r.MinZ && position.Z <= r.MaxZ
+}
+
 type TerrainData struct {
 	heightmapResolution    int32
 	size                   []float32
@@ -58,8 +81,11 @@ type TerrainData struct {
 	alphamaps              [][][]float32
 }
 
-func LoadLevel() (*LevelData, error) {
-	level, _ := loadLevel(config.WorldFilePath)
+func LoadLevel(filePath string) (*LevelData, error) {
+	level, err := loadLevel(filePath)
+	if err != nil {
+		return nil, err
+	}
 
 	byteArray := level[0]
 	fmt.Println("Loading level data ...")
@@ -71,9 +97,12 @@ func LoadLevel() (*LevelData, error) {
 	}
 
 	fmt.Print("==============================\n")
-	fmt.Printf("| Level size: %dx%d\n", int(gameData.TerrainData.size[0]), int(gameData.TerrainData.size[2]))
+	if gameData.TerrainCount > 0 {
+		fmt.Printf("| Level size: %dx%d\n", int(gameData.TerrainData.size[0]), int(gameData.TerrainData.size[2]))
+	}
 	fmt.Printf("| Objects count: %d\n", gameData.ObjectsCount)
 	fmt.Printf("| Teleports count: %d\n", len(gameData.Teleports))
+	fmt.Printf("| Regions count: %d\n", len(gameData.Regions))
 	fmt.Print("==============================\n")
 
 	return gameData, nil
@@ -103,6 +132,15 @@ func loadLevel(filename string) ([][]byte, error) {
 	return byteArrays, nil
 }
 
+// getSize returns the terrain size, levels without terrain (dungeons) use the default size
+func (l *LevelData) getSize() float64 {
+	if len(l.TerrainData.size) == 0 {
+		return DEFAULT_LEVEL_SIZE
+	}
+
+	return float64(l.TerrainData.size[0])
+}
+
 func (o *Object) isNPC() bool {
 	return o.kind == types.ObjectKindNPC
 }
@@ -191,7 +229,7 @@ func getTerrainData(stream *bytes.Reader) TerrainData {
 	alphamapHeight := getInt32(stream)
 	alphamapLayers := getInt32(stream)
 
-	alphamaps := getAlphamaps(stream, alphamapWidth, alphamapHeight, alphamapLayers)
+	alphamapTextureIndices, alphamaps := getAlphamaps(stream, alphamapWidth, alphamapHeight, alphamapLayers)
 
 	return TerrainData{
 		heightmapResolution: heightmapResolution,
@@ -199,17 +237,17 @@ func getTerrainData(stream *bytes.Reader) TerrainData {
 		heightmapWidth:      heightmapWidth,
 		heightmapHeight:     heightmapHeight,
 
-		alphamapResolution: alphamapResolution,
-		alphamapWidth:      alphamapWidth,
-		alphamapHeight:     alphamapHeight,
-		alphamapLayers:     alphamapLayers,
-		// alphamapTextureIndices: alphamapTextureIndices,
-		heightmap: heightmap,
-		alphamaps: alphamaps,
+		alphamapResolution:     alphamapResolution,
+		alphamapWidth:          alphamapWidth,
+		alphamapHeight:         alphamapHeight,
+		alphamapLayers:         alphamapLayers,
+		alphamapTextureIndices: alphamapTextureIndices,
+		heightmap:              heightmap,
+		alphamaps:              alphamaps,
 	}
 }
 
-func getAlphamaps(stream *bytes.Reader, alphamapWidth, alphamapHeight, alphamapLayers int32) [][][]float32 {
+func getAlphamaps(stream *bytes.Reader, alphamapWidth, alphamapHeight, alphamapLayers int32) ([]int32, [][][]float32) {
 
 	alphamapTextureIndices := make([]int32, alphamapLayers)
 	for i := 0; i < int(alphamapLayers); i++ {
@@ -232,7 +270,7 @@ func getAlphamaps(stream *bytes.Reader, alphamapWidth, alphamapHeight, alphamapL
 		}
 	}
 
-	return alphamaps
+	return alphamapTextureIndices, alphamaps
 }
 
 func getObjects(stream *bytes.Reader, count int32) []Object {
@@ -321,6 +359,26 @@ func loadLevelDataFromByteArray(byteArray []byte) (*LevelData, error) {
 
 	}
 
+	regions := make([]LevelRegion, 0)
+
+	for _, obj := range objects {
+		if obj.kind != types.ObjectKindRegion {
+			continue
+		}
+
+		halfX := float64(obj.scale[0]) / 2
+		halfZ := float64(obj.scale[2]) / 2
+
+		regions = append(regions, LevelRegion{
+			Name: obj.name,
+			Type: obj.meta,
+			MinX: float64(obj.position[0]) - halfX,
+			MinZ: float64(obj.position[2]) - halfZ,
+			MaxX: float64(obj.position[0]) + halfX,
+			MaxZ: float64(obj.position[2]) + halfZ,
+		})
+	}
+
 	gameData := &LevelData{
 		Version:      version,
 		Kind:         worldKind,
@@ -329,6 +387,7 @@ func loadLevelDataFromByteArray(byteArray []byte) (*LevelData, error) {
 		ObjectsCount: objectsCount,
 		Objects:      objects,
 		Teleports:    teleports,
+		Regions:      regions,
 	}
 
 	return gameData, nil
@@ -0,0 +1,74 @@
+package gameserver
+
+import (
+	"math"
+	"server/types"
+	"slices"
+)
+
+const (
+	EYE_HEIGHT        float64 = 1.5
+	LOS_SAMPLE_STEP   float64 = 0.5
+	MAX_OBSTACLE_SIZE float64 = 10 // Margin for obstacles with the center outside of the ray bounds
+)
+
+// canSee checks the line of sight between the eyes of both objects
+func (w *World) canSee(source, target *types.GameObject) bool {
+	from := source.Position
+	from.Y += EYE_HEIGHT
+
+	to := target.Position
+	to.Y += EYE_HEIGHT
+
+	return w.hasLineOfSight(from, to, source.UUID, target.UUID)
+}
+
+func (w *World) hasLineOfSight(from, to types.Vector3, ignoreUUIDs ...string) bool {
+	if w.isTerrainBlocking(from, to) {
+		return false
+	}
+
+	box := types.Box{
+		Min: types.Vector3f{math.Min(from.X, to.X) - MAX_OBSTACLE_SIZE, math.Min(from.Y, to.Y) - MAX_OBSTACLE_SIZE, math.Min(from.Z, to.Z) - MAX_OBSTACLE_SIZE},
+		Max: types.Vector3f{math.Max(from.X, to.X) + MAX_OBSTACLE_SIZE, math.Max(from.Y, to.Y) + MAX_OBSTACLE_SIZE, math.Max(from.Z, to.Z) + MAX_OBSTACLE_SIZE},
+	}
+
+	for _, obj := range w.elementsIn(box) {
+		if slices.Contains(ignoreUUIDs, obj.UUID) {
+			continue
+		}
+
+		collider := obj.GetCollider()
+		if collider == nil {
+			continue
+		}
+
+		if collider.IntersectsSegment(from, to) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isTerrainBlocking samples the heightmap along the segment
+func (w *World) isTerrainBlocking(from, to types.Vector3) bool {
+	if w.terrain == nil {
+		return false
+	}
+
+	steps := int(math.Ceil(distance(from, to) / LOS_SAMPLE_STEP))
+
+	for i := 1; i < steps; i++ {
+		t := float64(i) / float64(steps)
+		x := from.X + (to.X-from.X)*t
+		y := from.Y + (to.Y-from.Y)*t
+		z := from.Z + (to.Z-from.Z)*t
+
+		if w.terrain.HeightAt(x, z) > y {
+			return true
+		}
+	}
+
+	return false
+}
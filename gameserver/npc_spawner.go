@@ -4,14 +4,11 @@ import (
 	"fmt"
 	"server/entity"
 	"server/types"
-
-	"github.com/google/uuid"
 )
 
-func LoadNPC(object Object) {
+func LoadNPC(world *World, object Object) {
 	fmt.Println("NPC spawned: ", object.name)
 
-	myUUID, _ := uuid.NewUUID()
 	waypoints := [][3]float64{}
 
 	waypoints = append(waypoints, [3]float64{float64(object.position[0]), float64(object.position[2]), float64(object.rotation[1])})
@@ -20,11 +17,12 @@ func LoadNPC(object Object) {
 	}
 
 	position := types.Vector3{X: float64(object.position[0]), Y: float64(object.position[1]), Z: float64(object.position[2])}
+	position.Y = world.getGroundHeight(position)
 	rotation := types.Vector3{X: 0, Y: float64(object.rotation[1]), Z: 0}
 
 	npc := &types.GameObject{
 		Entity:        entity.EntityFactory(object.name),
-		UUID:          myUUID.String(),
+		UUID:          fmt.Sprintf("npc-%d", object.uid), // Stable between restarts to restore snapshots
 		Position:      position,
 		Rotation:      rotation,
 		PositionSpawn: position,
@@ -41,6 +39,6 @@ func LoadNPC(object Object) {
 
 	npc.SetNextTravelTime()
 
-	W.addObject(npc)
-	W.updateNeighbors(npc)
+	world.addObject(npc)
+	world.updateNeighbors(npc)
 }
@@ -0,0 +1,165 @@
+package gameserver
+
+import (
+	"fmt"
+	"server/types"
+	"time"
+)
+
+const (
+	AGRO_RADIUS     float64 = 10 // Default distance NPC notices players at
+	LEASH_DISTANCE  float64 = 20 // Default distance from spawn NPC chases up to
+	EVADE_HEAL_RATE float64 = 20 // Default percent of MaxHealth healed per second while evading
+
+	DAMAGE_THREAT_FACTOR        float64 = 1    // Threat per point of damage dealt to the NPC
+	PROXIMITY_THREAT            float64 = 1    // Threat of a player entering the agro radius
+	PROXIMITY_THREAT_PER_SECOND float64 = 1    // Threat of a player staying in the agro radius
+	THREAT_DECAY_PER_SECOND     float64 = 0.05 // Part of the threat forgotten every second
+	THREAT_SWITCH_MARGIN        float64 = 0.1  // Another player needs 10% more threat to take the target over
+)
+
+// NPC still walking home after it is teleported to the spawn
+const EVADE_TIMEOUT = 30 * time.Second
+
+// npcThreatTick updates the threat table of the NPC and picks the attack target from it
+func (w *World) npcThreatTick(npc *types.GameObject) {
+	threat := npc.GetThreat()
+	elapsed := threat.Decay(time.Now(), THREAT_DECAY_PER_SECOND)
+
+	// Forget players who died or left the world, guards forget players who left the town or lost the flag
+	for _, uuid := range threat.GetUUIDs() {
+		if player, err := w.getObject(uuid); err != nil || player.IsDead() || (npc.Entity.IsGuard && !w.isGuardTarget(npc, player)) {
+			threat.Remove(uuid)
+		}
+	}
+
+	agroRadius := w.getAgroRadius(npc)
+	for _, player := range npc.GetPlayersNearby() {
+		if player.IsDead() || distance(npc.Position, player.Position) >= agroRadius || !w.canSee(npc, player) {
+			continue
+		}
+
+		if npc.Entity.IsGuard && !w.isGuardTarget(npc, player) {
+			continue
+		}
+
+		if !threat.Has(player.UUID) {
+			threat.Add(player.UUID, PROXIMITY_THREAT)
+			continue
+		}
+
+		threat.Add(player.UUID, PROXIMITY_THREAT_PER_SECOND*elapsed)
+	}
+
+	target := threat.SelectTarget(npc.AttackTargetUUID, THREAT_SWITCH_MARGIN)
+	if target == npc.AttackTargetUUID {
+		return
+	}
+
+	// Nobody is left in the table, NPC goes back to patrol
+	if target == "" {
+		npc.ReleaseAttack()
+		npc.Path = nil
+		if waypoint := npc.GetNextRandomWaypoint(); waypoint != nil {
+			npc.SetDestination(waypoint[0], waypoint[1])
+		}
+		w.npcResetCurrentAnimation(npc)
+		return
+	}
+
+	npc.AttackTargetUUID = target
+	npc.AttackAttempts = 0
+	npc.Path = nil
+}
+
+// npcShouldEvade applies the chase rules: NPC gives up when it is led too far from spawn or the target runs away
+func (w *World) npcShouldEvade(npc *types.GameObject, targetDistance float64) bool {
+	spawn := npc.GetSpawnPoint()
+	if spawn == nil {
+		return false
+	}
+
+	if npc.Entity.ChaseDistance > 0 && targetDistance > float64(npc.Entity.ChaseDistance) {
+		return true
+	}
+
+	return distance(*spawn, npc.Position) > getLeashDistance(npc)
+}
+
+// npcEvade forgets all threat and walks the NPC home, it is immune and heals on the way
+func (w *World) npcEvade(npc *types.GameObject) {
+	fmt.Printf("NPC %s evades home\n", npc.Name)
+
+	npc.GetThreat().Clear()
+	npc.ReleaseAttack()
+	npc.AttackAttempts = 0
+	npc.Path = nil
+	w.npcResetCurrentAnimation(npc)
+
+	now := time.Now()
+	npc.IsReturningInProgress = true
+	npc.EvadeStartTime = &now
+	npc.SetDestination(npc.Waypoints[0][0], npc.Waypoints[0][1])
+
+	// No way home, the NPC would stay immune where it is
+	if len(npc.Path) == 0 {
+		w.npcReturnHome(npc)
+	}
+}
+
+// npcEvadeTimedOut is true when the evading NPC is stuck on the way home
+func (w *World) npcEvadeTimedOut(npc *types.GameObject) bool {
+	return npc.EvadeStartTime != nil && time.Since(*npc.EvadeStartTime) > EVADE_TIMEOUT
+}
+
+// npcReturnHome teleports the evading NPC to its spawn point and ends the evade
+func (w *World) npcReturnHome(npc *types.GameObject) {
+	fmt.Printf("NPC %s can't walk home, teleported to spawn\n", npc.Name)
+
+	w.Lock()
+	npc.Path = nil
+	npc.Position = npc.PositionSpawn
+	npc.Rotation = npc.RotationSpawn
+	w.Unlock()
+
+	w.onWalkUpdates(npc)
+	w.npcEvadeFinish(npc)
+
+	TeleportObjectChannel <- &types.TeleportObject{Object: npc, Position: npc.Position, Rotation: npc.Rotation}
+}
+
+func (w *World) npcEvadeFinish(npc *types.GameObject) {
+	npc.IsReturningInProgress = false
+	npc.EvadeStartTime = nil
+	npc.LastEvadeHealTime = nil
+}
+
+// npcEvadeHeal restores health of the evading NPC by the heal rate in whole points
+func (w *World) npcEvadeHeal(npc *types.GameObject) {
+	now := time.Now()
+	if npc.LastEvadeHealTime == nil || npc.Entity.Health >= npc.Entity.MaxHealth {
+		npc.LastEvadeHealTime = &now
+		return
+	}
+
+	rate := EVADE_HEAL_RATE
+	if npc.Entity.EvadeHealRate > 0 {
+		rate = float64(npc.Entity.EvadeHealRate)
+	}
+
+	heal := int32(float64(npc.Entity.MaxHealth) * rate / 100 * now.Sub(*npc.LastEvadeHealTime).Seconds())
+	if heal < 1 {
+		return
+	}
+
+	npc.LastEvadeHealTime = &now
+	w.healObject(npc, heal)
+}
+
+func getLeashDistance(npc *types.GameObject) float64 {
+	if npc.Entity.LeashDistance > 0 {
+		return float64(npc.Entity.LeashDistance)
+	}
+
+	return LEASH_DISTANCE
+}
@@ -0,0 +1,130 @@
+package gameserver
+
+import (
+	"errors"
+	"fmt"
+	"server/config"
+	"server/events"
+	"server/proto"
+	"server/proto/combatpb"
+	"server/types"
+	"slices"
+	"strings"
+	"time"
+)
+
+const RESPAWN_UNLOCK_RADIUS float64 = 3
+
+// playerDied leaves the corpse in place and lets the player choose the respawn point
+func (w *World) playerDied(killer, player *types.GameObject) {
+	now := time.Now()
+	player.DeathTime = &now
+	player.Path = nil
+	player.ReleaseAttack()
+
+	fmt.Printf("Player %s killed by %s\n", player.UUID, killer.UUID)
+
+	observerMsg := events.GetDeathPayload(player.UUID, killer.UUID, nil, 0)
+	for _, observer := range player.GetPlayersNearby() {
+		if observer.UUID != player.UUID {
+			TCPState.sendToClient(observer.UUID, observerMsg)
+		}
+	}
+
+	TCPState.sendToClient(player.UUID, events.GetDeathPayload(player.UUID, killer.UUID, getRespawnPoints(player), float32(config.RespawnDelay.Seconds())))
+}
+
+func ActionRespawn(world *World, player *types.GameObject, respawn *combatpb.Respawn) {
+	if player.DeathTime != nil && time.Since(*player.DeathTime) < config.RespawnDelay {
+		sendMessage(player.UUID, "Respawn is not ready yet")
+		return
+	}
+
+	targetWorld, teleport, err := resolveRespawnPoint(player, respawn.RespawnPoint)
+	if err != nil {
+		sendMessage(player.UUID, err.Error())
+		return
+	}
+
+	player.Entity.Health = player.Entity.MaxHealth
+	player.DeathTime = nil
+
+	world.teleportObject(player, targetWorld, teleport)
+
+	// Observers and the player get the object with the restored health
+	TCPState.sendToClient(player.UUID, events.GetObjectEventPayload(player, &types.EventPayloadOptions{IsSelf: true}))
+	for _, observer := range player.GetPlayersNearby() {
+		if observer.UUID != player.UUID {
+			TCPState.sendToClient(observer.UUID, events.GetObjectEventPayload(player, &types.EventPayloadOptions{}))
+		}
+	}
+}
+
+func getDefaultRespawnPoint() string {
+	return W.Name + ":" + config.DefaultRespawn
+}
+
+func getRespawnPoints(player *types.GameObject) []*combatpb.RespawnPoint {
+	names := append([]string{getDefaultRespawnPoint()}, player.UnlockedRespawns...)
+	points := make([]*combatpb.RespawnPoint, 0, len(names))
+
+	for _, name := range names {
+		world, teleport, err := resolveRespawnPoint(player, name)
+		if err != nil {
+			continue
+		}
+
+		position := world.getArrivalPosition(teleport)
+		points = append(points, &combatpb.RespawnPoint{
+			Name:     name,
+			Level:    world.Level,
+			Position: &proto.Vector3M{X: float32(position.X), Y: float32(position.Y), Z: float32(position.Z)},
+		})
+	}
+
+	return points
+}
+
+func resolveRespawnPoint(player *types.GameObject, name string) (*World, *LevelTeleport, error) {
+	if name != getDefaultRespawnPoint() && !slices.Contains(player.UnlockedRespawns, name) {
+		return nil, nil, errors.New("respawn point is locked")
+	}
+
+	worldName, teleportName, _ := strings.Cut(name, ":")
+	world := Worlds.get(worldName)
+	if world == nil {
+		return nil, nil, errors.New("respawn point not found")
+	}
+
+	teleport := world.getTeleport(teleportName)
+	if teleport == nil {
+		return nil, nil, errors.New("respawn point not found")
+	}
+
+	return world, teleport, nil
+}
+
+// unlockRespawnPoints remembers the respawn points the player walks by, instances have none to keep
+func (w *World) unlockRespawnPoints(player *types.GameObject) {
+	if Instances.isInstance(w.Name) {
+		return
+	}
+
+	for _, teleport := range w.teleports {
+		if !strings.HasPrefix(teleport.Name, config.RespawnPointPrefix) {
+			continue
+		}
+
+		name := w.Name + ":" + teleport.Name
+		if slices.Contains(player.UnlockedRespawns, name) || distance2D(player.Position, teleport.Position) > RESPAWN_UNLOCK_RADIUS {
+			continue
+		}
+
+		player.UnlockedRespawns = append(player.UnlockedRespawns, name)
+		sendMessage(player.UUID, "Respawn point unlocked")
+	}
+}
+
+func sendMessage(uuid, text string) {
+	TCPState.sendToClient(uuid, events.GetMessageEventPayload("", uuid, text))
+}
@@ -0,0 +1,296 @@
+package gameserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"server/config"
+	"server/entity"
+	"server/types"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PlayerRecord is the persisted state of a player character
+type PlayerRecord struct {
+	Account        string
+	Name           string // Character name, unique per account
+	WorldName      string
+	Position       types.Vector3
+	Rotation       types.Vector3
+	Health         int32
+	MaxHealth      int32
+	HumanCharacter entity.HumanCharacter
+	RightHand      string // Item internal names
+	LeftHand       string
+	Respawns       []string // Unlocked respawn points
+	SavedAt        time.Time
+}
+
+type PlayerRepository interface {
+	List(account string) ([]*PlayerRecord, error)
+	Load(account, name string) (*PlayerRecord, error) // Returns nil record for unknown character
+	Save(record *PlayerRecord) error
+}
+
+// FilePlayerRepository keeps every character in its own JSON file in the account directory
+type FilePlayerRepository struct {
+	sync.Mutex
+	dir string
+}
+
+var Players PlayerRepository = NewFilePlayerRepository(config.PlayersDir)
+
+func NewFilePlayerRepository(dir string) *FilePlayerRepository {
+	return &FilePlayerRepository{dir: dir}
+}
+
+var ErrInvalidName = errors.New("invalid account or character name")
+
+// getPath refuses names which are not plain file names, they could point outside of the account directory
+func (r *FilePlayerRepository) getPath(account, name string) (string, error) {
+	if !accountNameRegexp.MatchString(account) || !accountNameRegexp.MatchString(name) {
+		return "", ErrInvalidName
+	}
+
+	return filepath.Join(r.dir, account, name+".json"), nil
+}
+
+func (r *FilePlayerRepository) List(account string) ([]*PlayerRecord, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !accountNameRegexp.MatchString(account) {
+		return nil, ErrInvalidName
+	}
+
+	files, err := filepath.Glob(filepath.Join(r.dir, account, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*PlayerRecord, 0, len(files))
+	for _, file := range files {
+		record, err := readPlayerRecord(file)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (r *FilePlayerRepository) Load(account, name string) (*PlayerRecord, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	path, err := r.getPath(account, name)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := readPlayerRecord(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	return record, err
+}
+
+func readPlayerRecord(path string) (*PlayerRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &PlayerRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return record, nil
+}
+
+// Save replaces the character file atomically
+func (r *FilePlayerRepository) Save(record *PlayerRecord) error {
+	r.Lock()
+	defer r.Unlock()
+
+	path, err := r.getPath(record.Account, record.Name)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(r.dir, record.Account)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dir, record.Name+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// MigrateLegacyFiles moves the single character <dir>/<account>.json files of the first storage layout
+// to <dir>/<account>/<account>.json, the character is named after the account
+func (r *FilePlayerRepository) MigrateLegacyFiles() error {
+	files, err := filepath.Glob(filepath.Join(r.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, file := range files {
+		record, err := readPlayerRecord(file)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if record.Account == "" {
+			record.Account = strings.TrimSuffix(filepath.Base(file), ".json")
+		}
+		if record.Name == "" {
+			record.Name = record.Account
+		}
+
+		if existing, err := r.Load(record.Account, record.Name); err != nil || existing != nil {
+			errs = append(errs, fmt.Errorf("%s: character %s/%s already exists", file, record.Account, record.Name))
+			continue
+		}
+
+		if err := r.Save(record); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+
+		// Keep the old file around until the migration is verified
+		if err := os.Rename(file, file+".migrated"); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		fmt.Printf("Player %s migrated to %s/%s\n", file, record.Account, record.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+const DEFAULT_PLAYER_WEAPON = "dragon_axe"
+
+// newPlayerRecord creates a character with the appearance chosen on character creation
+func newPlayerRecord(account, name string, humanCharacter entity.HumanCharacter) *PlayerRecord {
+	return &PlayerRecord{
+		Account:        account,
+		Name:           name,
+		Health:         200,
+		MaxHealth:      200,
+		HumanCharacter: humanCharacter,
+		RightHand:      DEFAULT_PLAYER_WEAPON,
+	}
+}
+
+func getPlayerRecord(account, name string, obj *types.GameObject) *PlayerRecord {
+	record := &PlayerRecord{
+		Account:   account,
+		Name:      name,
+		WorldName: obj.WorldName,
+		Position:  obj.Position,
+		Rotation:  obj.Rotation,
+		Health:    obj.Entity.Health,
+		MaxHealth: obj.Entity.MaxHealth,
+		Respawns:  obj.UnlockedRespawns,
+		SavedAt:   time.Now(),
+	}
+
+	if obj.Entity.HumanCharacter != nil {
+		record.HumanCharacter = *obj.Entity.HumanCharacter
+	}
+
+	if obj.Entity.EquippedItems != nil {
+		record.RightHand = obj.Entity.EquippedItems.RightHand.InternalName
+		record.LeftHand = obj.Entity.EquippedItems.LeftHand.InternalName
+	}
+
+	return record
+}
+
+func getPlayerEntity(record *PlayerRecord) entity.Entity {
+	humanCharacter := record.HumanCharacter
+
+	for _, item := range []string{record.RightHand, record.LeftHand} {
+		if item != "" && !entity.HasItem(item) {
+			fmt.Printf("Player %s/%s has unknown item %q, it is dropped\n", record.Account, record.Name, item)
+		}
+	}
+
+	playerEntity := entity.Entity{
+		Name:           record.Name,
+		Speed:          2,
+		Health:         record.Health,
+		MaxHealth:      record.MaxHealth,
+		HumanCharacter: &humanCharacter,
+		EquippedItems: &entity.EquippedItems{
+			RightHand: entity.GetItem(record.RightHand),
+			LeftHand:  entity.GetItem(record.LeftHand),
+		},
+	}
+	playerEntity.EquippedItems.RightHand.Clip = playerEntity.EquippedItems.RightHand.ClipSize
+
+	return playerEntity
+}
+
+func savePlayer(client *types.TCPClient, obj *types.GameObject) {
+	if err := Players.Save(getPlayerRecord(client.Account, client.Character, obj)); err != nil {
+		fmt.Printf("Error saving player %s/%s: %v\n", client.Account, client.Character, err)
+	}
+}
+
+// ProcessPlayersSave periodically saves the online players so a crash loses little progress
+func ProcessPlayersSave() {
+	ticker := time.NewTicker(config.PlayerSaveInterval)
+	for range ticker.C {
+		clients := []types.TCPClient{}
+
+		TCPState.RLock()
+		for _, client := range TCPState.clients {
+			if client.Character != "" {
+				clients = append(clients, *client)
+			}
+		}
+		TCPState.RUnlock()
+
+		for i := range clients {
+			if _, obj, err := Worlds.findObject(clients[i].UUID); err == nil {
+				savePlayer(&clients[i], obj)
+			}
+		}
+	}
+}
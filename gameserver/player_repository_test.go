@@ -0,0 +1,32 @@
+package gameserver
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFilePlayerRepositoryStaysInItsDirectory(t *testing.T) {
+	repository := NewFilePlayerRepository(t.TempDir())
+	if err := repository.Save(&PlayerRecord{Account: "victim", Name: "Hero"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"../victim/Hero", "..", "Hero/../Hero", ""} {
+		if _, err := repository.Load("attacker", name); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Load(attacker, %q) error = %v, want ErrInvalidName", name, err)
+		}
+
+		if err := repository.Save(&PlayerRecord{Account: "attacker", Name: name}); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("Save(attacker, %q) error = %v, want ErrInvalidName", name, err)
+		}
+	}
+
+	if _, err := repository.List("../victim"); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("List(../victim) error = %v, want ErrInvalidName", err)
+	}
+
+	record, err := repository.Load("victim", "Hero")
+	if err != nil || record == nil {
+		t.Errorf("Load(victim, Hero) = %v, %v", record, err)
+	}
+}
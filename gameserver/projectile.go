@@ -0,0 +1,246 @@
+package gameserver
+
+import (
+	"math"
+	"server/events"
+	"server/proto/actionpb"
+	"server/types"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CHARACTER_HEIGHT         float64 = 1.8
+	PROJECTILE_HIT_PRECISION         = 8 // Bisection steps to find the impact point on obstacles
+)
+
+// Projectile is simulated by the zone it was fired in, collisions are checked along the path flown every tick
+type Projectile struct {
+	UUID         string
+	Source       *types.GameObject
+	Position     types.Vector3
+	Direction    types.Vector3
+	Speed        float64
+	MaxRange     float64
+	Traveled     float64
+	LastTickTime time.Time
+	IsFinished   bool
+}
+
+func (w *World) spawnProjectile(source, target *types.GameObject) {
+	weapon := source.GetAttackWeapon()
+
+	from := eyePosition(source.Position)
+	to := eyePosition(target.Position)
+	length := distance(from, to)
+	if length == 0 {
+		w.resolveHit(source, target)
+		return
+	}
+
+	maxRange := float64(weapon.ProjectileRange)
+	if maxRange == 0 {
+		maxRange = float64(weapon.AttackRange)
+	}
+
+	projectileUUID, _ := uuid.NewUUID()
+	projectile := &Projectile{
+		UUID:     projectileUUID.String(),
+		Source:   source,
+		Position: from,
+		Direction: types.Vector3{
+			X: (to.X - from.X) / length,
+			Y: (to.Y - from.Y) / length,
+			Z: (to.Z - from.Z) / length,
+		},
+		Speed:        float64(weapon.ProjectileSpeed),
+		MaxRange:     maxRange,
+		LastTickTime: time.Now(),
+	}
+
+	zone := w.zoneAt(from.X, from.Z)
+	zone.Lock()
+	zone.projectiles = append(zone.projectiles, projectile)
+	zone.Unlock()
+
+	w.broadcastNearby(from, events.GetProjectileSpawnPayload(projectile.UUID, source.UUID, weapon.ProjectileResource, from, projectile.Direction, weapon.ProjectileSpeed, float32(maxRange)))
+}
+
+func (z *Zone) projectileTick() {
+	z.RLock()
+	projectiles := append([]*Projectile{}, z.projectiles...)
+	z.RUnlock()
+
+	if len(projectiles) == 0 {
+		return
+	}
+
+	for _, projectile := range projectiles {
+		z.world.stepProjectile(projectile)
+	}
+
+	z.Lock()
+	active := z.projectiles[:0]
+	for _, projectile := range z.projectiles {
+		if !projectile.IsFinished {
+			active = append(active, projectile)
+		}
+	}
+	z.projectiles = active
+	z.Unlock()
+}
+
+// stepProjectile moves the projectile by the time passed since the last tick and resolves the first hit on the way
+func (w *World) stepProjectile(projectile *Projectile) {
+	now := time.Now()
+	step := math.Min(projectile.Speed*now.Sub(projectile.LastTickTime).Seconds(), projectile.MaxRange-projectile.Traveled)
+	projectile.LastTickTime = now
+
+	from := projectile.Position
+	to := types.Vector3{
+		X: from.X + projectile.Direction.X*step,
+		Y: from.Y + projectile.Direction.Y*step,
+		Z: from.Z + projectile.Direction.Z*step,
+	}
+
+	target, targetFraction := w.findProjectileTarget(projectile, from, to)
+	obstacleFraction := w.findObstacleFraction(from, to, projectile.Source.UUID)
+
+	switch {
+	case obstacleFraction < targetFraction:
+		w.finishProjectile(projectile, lerpVector(from, to, obstacleFraction), nil)
+	case target != nil:
+		w.finishProjectile(projectile, lerpVector(from, to, targetFraction), target)
+	default:
+		projectile.Position = to
+		projectile.Traveled += step
+
+		if projectile.Traveled >= projectile.MaxRange {
+			w.finishProjectile(projectile, to, nil)
+		}
+	}
+}
+
+// findProjectileTarget returns the closest character on the segment and the path fraction to it
+func (w *World) findProjectileTarget(projectile *Projectile, from, to types.Vector3) (*types.GameObject, float64) {
+	box := types.Box{
+		Min: types.Vector3f{math.Min(from.X, to.X) - CHARACTER_HEIGHT, math.Min(from.Y, to.Y) - CHARACTER_HEIGHT, math.Min(from.Z, to.Z) - CHARACTER_HEIGHT},
+		Max: types.Vector3f{math.Max(from.X, to.X) + CHARACTER_HEIGHT, math.Max(from.Y, to.Y) + CHARACTER_HEIGHT, math.Max(from.Z, to.Z) + CHARACTER_HEIGHT},
+	}
+
+	step := distance(from, to)
+
+	var closest *types.GameObject
+	closestFraction := math.Inf(1)
+
+	for _, obj := range w.elementsIn(box) {
+		if !w.canDamage(projectile.Source, obj) {
+			continue
+		}
+
+		radius := PLAYER_RADIUS
+		if obj.Type == types.ObjectTypeNPC {
+			radius = NPC_RADIUS
+		}
+
+		if !types.SegmentHitsCapsule(from, to, obj.Position, CHARACTER_HEIGHT, radius) {
+			continue
+		}
+
+		fraction := 0.0
+		if step > 0 {
+			along := (obj.Position.X-from.X)*projectile.Direction.X + (obj.Position.Z-from.Z)*projectile.Direction.Z
+			fraction = math.Max(0, math.Min(1, along/step))
+		}
+
+		if fraction < closestFraction {
+			closest = obj
+			closestFraction = fraction
+		}
+	}
+
+	return closest, closestFraction
+}
+
+// findObstacleFraction returns the path fraction where the segment enters the ground or a static object, +Inf when free
+func (w *World) findObstacleFraction(from, to types.Vector3, ignoreUUID string) float64 {
+	if !w.isSegmentBlocked(from, to, ignoreUUID) {
+		return math.Inf(1)
+	}
+
+	low, high := 0.0, 1.0
+	for i := 0; i < PROJECTILE_HIT_PRECISION; i++ {
+		middle := (low + high) / 2
+		if w.isSegmentBlocked(from, lerpVector(from, to, middle), ignoreUUID) {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+
+	return high
+}
+
+func (w *World) isSegmentBlocked(from, to types.Vector3, ignoreUUID string) bool {
+	if w.getGroundHeight(to) > to.Y || w.isTerrainBlocking(from, to) {
+		return true
+	}
+
+	for _, obj := range w.elementsIn(types.Box{
+		Min: types.Vector3f{math.Min(from.X, to.X) - MAX_OBSTACLE_SIZE, math.Min(from.Y, to.Y) - MAX_OBSTACLE_SIZE, math.Min(from.Z, to.Z) - MAX_OBSTACLE_SIZE},
+		Max: types.Vector3f{math.Max(from.X, to.X) + MAX_OBSTACLE_SIZE, math.Max(from.Y, to.Y) + MAX_OBSTACLE_SIZE, math.Max(from.Z, to.Z) + MAX_OBSTACLE_SIZE},
+	}) {
+		if obj.UUID == ignoreUUID {
+			continue
+		}
+
+		if collider := obj.GetCollider(); collider != nil && collider.IntersectsSegment(from, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *World) finishProjectile(projectile *Projectile, position types.Vector3, target *types.GameObject) {
+	projectile.IsFinished = true
+	projectile.Position = position
+
+	targetUUID := ""
+	if target != nil {
+		targetUUID = target.UUID
+	}
+
+	w.broadcastNearby(position, events.GetProjectileImpactPayload(projectile.UUID, position, targetUUID))
+
+	if target != nil {
+		w.resolveHit(projectile.Source, target)
+		return
+	}
+
+	// Explosives detonate on obstacles as well
+	weapon := projectile.Source.GetAttackWeapon()
+	if weapon.IsExplosive {
+		targets := map[*types.GameObject]float64{}
+		w.addSplashTargets(targets, projectile.Source, position, float64(weapon.AttackRadius))
+
+		for obj, scale := range targets {
+			w.applyDamage(projectile.Source, obj, scale)
+		}
+	}
+}
+
+func (w *World) broadcastNearby(position types.Vector3, msg *actionpb.Action) {
+	for _, player := range w.getPlayersByPosition(position, AREA_OF_INTEREST) {
+		TCPState.sendToClient(player.UUID, msg)
+	}
+}
+
+func lerpVector(from, to types.Vector3, t float64) types.Vector3 {
+	return types.Vector3{
+		X: from.X + (to.X-from.X)*t,
+		Y: from.Y + (to.Y-from.Y)*t,
+		Z: from.Z + (to.Z-from.Z)*t,
+	}
+}
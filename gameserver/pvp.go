@@ -0,0 +1,123 @@
+package gameserver
+
+import (
+	"fmt"
+	"server/config"
+	"server/events"
+	"server/proto/actionpb"
+	"server/proto/combatpb"
+	"server/types"
+	"time"
+)
+
+// getPvPMode returns the PvP mode of the level, instances share it with their level
+func (w *World) getPvPMode() config.PvPMode {
+	level := config.GetLevel(w.Level)
+	if level == nil || level.PvP == "" {
+		return config.PvPOptIn
+	}
+
+	return level.PvP
+}
+
+func (w *World) isInRegion(position types.Vector3, regionType RegionType) bool {
+	for _, region := range w.regions {
+		if region.Type == regionType && region.contains(position) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *World) isInSafeZone(position types.Vector3) bool {
+	return w.isInRegion(position, RegionSafe)
+}
+
+// isPvPEnabled checks if the player can fight other players at the current position
+func (w *World) isPvPEnabled(player *types.GameObject) bool {
+	switch {
+	case w.getPvPMode() == config.PvPAlways:
+		return true
+	case w.getPvPMode() == config.PvPZone && w.isInRegion(player.Position, RegionPvP):
+		return true
+	}
+
+	return player.IsPvPFlagged()
+}
+
+// canPvP applies the PvP rules to an attack of one player on another
+func (w *World) canPvP(source, target *types.GameObject) bool {
+	return w.isPvPEnabled(source) && w.isPvPEnabled(target)
+}
+
+// isGuardTarget checks if the guard should attack the player: flagged and inside a safe zone
+func (w *World) isGuardTarget(guard, player *types.GameObject) bool {
+	return guard.Entity.IsGuard && player.Type == types.ObjectTypePlayer && player.IsPvPFlagged() && w.isInSafeZone(player.Position)
+}
+
+// flagPvPCombat flags the player who attacked another player for the guards, every hit extends the flag
+// and the nearby players are only told when the player becomes flagged
+func (w *World) flagPvPCombat(player *types.GameObject) {
+	isFlagged := player.IsPvPFlagged()
+
+	flagTime := time.Now().Add(config.PvPCombatFlagDuration)
+	player.PvPCombatFlagTime = &flagTime
+
+	if !isFlagged {
+		w.broadcastPvPStatus(player)
+	}
+}
+
+func ActionPvPFlag(world *World, player *types.GameObject, action *combatpb.PvPFlag) {
+	if world.getPvPMode() != config.PvPOptIn {
+		sendMessage(player.UUID, "PvP flag can't be changed on this map")
+		return
+	}
+
+	if player.IsPvPOptIn == action.IsFlagged {
+		return
+	}
+
+	fmt.Printf("Player %s PvP flag: %t\n", player.UUID, action.IsFlagged)
+
+	player.IsPvPOptIn = action.IsFlagged
+	world.broadcastPvPStatus(player)
+}
+
+// checkSafeZone tells the player when they enter or leave a safe zone
+func (w *World) checkSafeZone(player *types.GameObject) {
+	isInSafeZone := w.isInSafeZone(player.Position)
+	if player.IsInSafeZone == isInSafeZone {
+		return
+	}
+
+	player.IsInSafeZone = isInSafeZone
+	if isInSafeZone {
+		sendMessage(player.UUID, "You entered a safe zone")
+	} else {
+		sendMessage(player.UUID, "You left the safe zone")
+	}
+
+	TCPState.sendToClient(player.UUID, getPvPStatusPayload(player))
+}
+
+func (w *World) broadcastPvPStatus(player *types.GameObject) {
+	msg := getPvPStatusPayload(player)
+
+	TCPState.sendToClient(player.UUID, msg)
+	for _, observer := range player.GetPlayersNearby() {
+		if observer.UUID != player.UUID {
+			TCPState.sendToClient(observer.UUID, msg)
+		}
+	}
+}
+
+func getPvPStatusPayload(player *types.GameObject) *actionpb.Action {
+	var combatFlagDuration time.Duration
+	if player.PvPCombatFlagTime != nil {
+		combatFlagDuration = max(time.Until(*player.PvPCombatFlagTime), 0)
+	}
+
+	return events.GetPvPStatusPayload(player.UUID, player.IsPvPFlagged(), float32(combatFlagDuration.Seconds()), player.IsInSafeZone)
+}
@@ -0,0 +1,106 @@
+package gameserver
+
+import (
+	"server/config"
+	"server/entity"
+	"server/types"
+	"testing"
+	"time"
+)
+
+// Town is a safe zone, the arena is a PvP region and the field between them is neither
+var (
+	town  = types.Vector3{X: 5}
+	field = types.Vector3{X: 15}
+	arena = types.Vector3{X: 25}
+)
+
+func newRulesWorld(t *testing.T, mode config.PvPMode) *World {
+	levels := config.Levels
+	t.Cleanup(func() { config.Levels = levels })
+	config.Levels = []config.LevelConfig{{Name: "rules", PvP: mode}}
+
+	return &World{
+		Name:  "rules",
+		Level: "rules",
+		regions: []*LevelRegion{
+			{Name: "town", Type: RegionSafe, MinX: 0, MaxX: 10, MinZ: -10, MaxZ: 10},
+			{Name: "arena", Type: RegionPvP, MinX: 20, MaxX: 30, MinZ: -10, MaxZ: 10},
+		},
+	}
+}
+
+func newPlayer(uuid string, position types.Vector3, isOptIn bool) *types.GameObject {
+	return &types.GameObject{UUID: uuid, Type: types.ObjectTypePlayer, Position: position, IsPvPOptIn: isOptIn, Entity: entity.Entity{Health: 100}}
+}
+
+func newCombatFlagged(uuid string, position types.Vector3) *types.GameObject {
+	player := newPlayer(uuid, position, false)
+	flagTime := time.Now().Add(time.Minute)
+	player.PvPCombatFlagTime = &flagTime
+
+	return player
+}
+
+func newNPC(uuid string, position types.Vector3, isGuard bool) *types.GameObject {
+	return &types.GameObject{UUID: uuid, Type: types.ObjectTypeNPC, Position: position, Entity: entity.Entity{Health: 100, IsGuard: isGuard}}
+}
+
+func TestCanDamage(t *testing.T) {
+	evading := newNPC("evading", field, false)
+	evading.IsReturningInProgress = true
+
+	dead := newPlayer("dead", field, true)
+	dead.Entity.Health = 0
+
+	self := newPlayer("self", field, true)
+
+	tests := []struct {
+		name           string
+		mode           config.PvPMode
+		source, target *types.GameObject
+		want           bool
+	}{
+		// Opt-in: both players have to be flagged, the PvP regions don't matter
+		{"opt-in unflagged", config.PvPOptIn, newPlayer("a", field, false), newPlayer("b", field, false), false},
+		{"opt-in both flagged", config.PvPOptIn, newPlayer("a", field, true), newPlayer("b", field, true), true},
+		{"opt-in only the attacker flagged", config.PvPOptIn, newPlayer("a", field, true), newPlayer("b", field, false), false},
+		{"opt-in combat flag counts", config.PvPOptIn, newCombatFlagged("a", field), newPlayer("b", field, true), true},
+		{"opt-in arena is not special", config.PvPOptIn, newPlayer("a", arena, false), newPlayer("b", arena, false), false},
+
+		// Zone: everyone in the PvP regions, flagged players elsewhere
+		{"zone both in the arena", config.PvPZone, newPlayer("a", arena, false), newPlayer("b", arena, false), true},
+		{"zone target outside the arena", config.PvPZone, newPlayer("a", arena, false), newPlayer("b", field, false), false},
+		{"zone flagged outside the arena", config.PvPZone, newPlayer("a", field, true), newPlayer("b", field, true), true},
+		{"zone unflagged outside the arena", config.PvPZone, newPlayer("a", field, false), newPlayer("b", field, false), false},
+
+		// Always: everyone outside of safe zones
+		{"always unflagged", config.PvPAlways, newPlayer("a", field, false), newPlayer("b", field, false), true},
+		{"always target in town", config.PvPAlways, newPlayer("a", field, false), newPlayer("b", town, false), false},
+		{"always attacker in town", config.PvPAlways, newPlayer("a", town, false), newPlayer("b", field, false), false},
+
+		// Safe zones: only guards fight flagged players
+		{"player on NPC in town", config.PvPOptIn, newPlayer("a", town, false), newNPC("npc", town, false), false},
+		{"NPC on player in town", config.PvPOptIn, newNPC("npc", town, false), newPlayer("a", town, false), false},
+		{"flagged players in town", config.PvPOptIn, newPlayer("a", town, true), newPlayer("b", town, true), false},
+		{"guard on flagged player in town", config.PvPOptIn, newNPC("guard", town, true), newPlayer("a", town, true), true},
+		{"guard on combat flagged player in town", config.PvPOptIn, newNPC("guard", town, true), newCombatFlagged("a", town), true},
+		{"guard on unflagged player in town", config.PvPOptIn, newNPC("guard", town, true), newPlayer("a", town, false), false},
+		{"guard on player outside of town", config.PvPOptIn, newNPC("guard", field, true), newPlayer("a", field, false), true},
+
+		// Players and NPCs
+		{"player on NPC", config.PvPOptIn, newPlayer("a", field, false), newNPC("npc", field, false), true},
+		{"NPC on player", config.PvPOptIn, newNPC("npc", field, false), newPlayer("a", field, false), true},
+		{"NPC on NPC", config.PvPAlways, newNPC("npc", field, false), newNPC("other", field, false), false},
+		{"evading NPC is immune", config.PvPOptIn, newPlayer("a", field, false), evading, false},
+		{"dead target", config.PvPAlways, newPlayer("a", field, true), dead, false},
+		{"self", config.PvPAlways, self, self, false},
+	}
+
+	for _, test := range tests {
+		world := newRulesWorld(t, test.mode)
+		if got := world.canDamage(test.source, test.target); got != test.want {
+			t.Errorf("%s: %t, want %t", test.name, got, test.want)
+		}
+	}
+}
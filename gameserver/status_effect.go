@@ -0,0 +1,95 @@
+package gameserver
+
+import (
+	"math/rand"
+	"server/entity"
+	"server/types"
+	"time"
+)
+
+// statusEffectTick deals the damage and heal ticks of active effects and removes the expired ones
+func (w *World) statusEffectTick(objects []*types.GameObject) {
+	now := time.Now()
+
+	for _, object := range objects {
+		if object.Effects == nil || object.IsDead() {
+			continue
+		}
+
+		ticks, expired := object.Effects.Tick(now)
+
+		for _, tick := range ticks {
+			if object.IsDead() {
+				break
+			}
+
+			if tick.Amount < 0 {
+				w.healObject(object, -tick.Amount)
+				continue
+			}
+
+			if tick.Effect.Source == nil || !w.canDamage(tick.Effect.Source, object) {
+				continue
+			}
+
+			w.dealDamage(tick.Effect.Source, object, tick.Amount, false)
+		}
+
+		for _, effect := range expired {
+			StatusEffectChannel <- &types.StatusEffectUpdate{Object: object, Effect: effect, IsRemoved: true}
+		}
+	}
+}
+
+// applyStatusEffect puts the effect on the target and tells the nearby players about it
+func (w *World) applyStatusEffect(source, target *types.GameObject, effect entity.StatusEffect) {
+	if target.IsDead() {
+		return
+	}
+
+	active, changed := target.GetEffects().Apply(effect, source, time.Now())
+	if !changed {
+		return
+	}
+
+	StatusEffectChannel <- &types.StatusEffectUpdate{Object: target, Effect: active}
+
+	// Stunned NPCs stop where they are
+	if effect.IsStun && target.Type == types.ObjectTypeNPC {
+		target.Path = nil
+		w.npcResetCurrentAnimation(target)
+	}
+}
+
+// applyHitEffects rolls the on hit effects of the attacker weapon
+func (w *World) applyHitEffects(source, target *types.GameObject) {
+	for _, effect := range source.GetAttackWeapon().Effects {
+		if effect.Chance > 0 && rand.Float32()*100 > effect.Chance {
+			continue
+		}
+
+		w.applyStatusEffect(source, target, effect)
+	}
+}
+
+// useConsumable applies the effects of the consumed item to the user
+func (w *World) useConsumable(object *types.GameObject, item entity.Entity) {
+	if item.Type != entity.TypeConsumable {
+		return
+	}
+
+	for _, effect := range item.Effects {
+		w.applyStatusEffect(object, object, effect)
+	}
+}
+
+func (w *World) healObject(object *types.GameObject, amount int32) {
+	amount = min(amount, object.Entity.MaxHealth-object.Entity.Health)
+	if amount <= 0 {
+		return
+	}
+
+	object.Entity.Health += amount
+
+	DamageChannel <- &types.Damage{Object: object, Amount: -amount, HealthCurrent: object.Entity.Health, HealthMax: object.Entity.MaxHealth}
+}
@@ -21,14 +21,26 @@ var DestroyObjectChannel = make(chan *types.DestroyObject, bufferSize)
 var SpawnObjectChannel = make(chan *types.SpawnObject, bufferSize)
 var TeleportObjectChannel = make(chan *types.TeleportObject, bufferSize)
 var InteractQueue = make(chan *types.InteractQueue, bufferSize)
+var StatusEffectChannel = make(chan *types.StatusEffectUpdate, bufferSize)
 
 func ProcessMovementUpdates() {
 	for obj := range UpdateMovementChannel {
-		W.onWalkUpdates(obj)
+		world := Worlds.getObjectWorld(obj)
+		if world == nil {
+			continue
+		}
+
+		world.onWalkUpdates(obj)
+
+		if obj.Type == types.ObjectTypePlayer {
+			world.unlockRespawnPoints(obj)
+			world.checkSafeZone(obj)
+			world.checkTeleportTrigger(obj)
+		}
 
 		msg := &transformpb.Transform{
 			UUID:     obj.UUID,
-			Speed:    obj.Speed,
+			Speed:    obj.GetSpeed(),
 			Position: &proto.Vector3M{X: float32(obj.Position.X), Y: float32(obj.Position.Y), Z: float32(obj.Position.Z)},
 			Rotation: &proto.Vector3M{X: float32(obj.Rotation.X), Y: float32(obj.Rotation.Y), Z: float32(obj.Rotation.Z)},
 		}
@@ -70,6 +82,23 @@ func ProcessDamage() {
 	}
 }
 
+func ProcessStatusEffects() {
+	for request := range StatusEffectChannel {
+		msg := events.GetStatusEffectPayload(request.Object.UUID, request.Effect)
+		if request.IsRemoved {
+			msg = events.GetStatusEffectRemovePayload(request.Object.UUID, request.Effect.Effect.Name)
+		}
+
+		if request.Object.Type == types.ObjectTypePlayer {
+			TCPState.sendToClient(request.Object.UUID, msg)
+		}
+
+		for _, player := range request.Object.GetPlayersNearby() {
+			TCPState.sendToClient(player.UUID, msg)
+		}
+	}
+}
+
 func ProcessObjectDestroy() {
 	for request := range DestroyObjectChannel {
 		msg := events.GetDestroyObjectEventPayload(request.Object.UUID)
@@ -129,7 +158,7 @@ func ProcessTeleportObjectUpdates() {
 		players = append(players, request.Object)
 
 		for _, player := range players {
-			msg := events.GetTeleportEventPayload(request.Object.UUID, request.Position, request.Rotation)
+			msg := events.GetTeleportEventPayload(request.Object.UUID, request.Position, request.Rotation, request.Level)
 			TCPState.sendToClient(player.UUID, msg)
 		}
 	}
@@ -137,7 +166,12 @@ func ProcessTeleportObjectUpdates() {
 
 func ProcessSoundBroadcast() {
 	for request := range BroadcastSoundChannel {
-		listeners := W.getPlayersByPosition(request.Position, 50)
+		world := Worlds.get(request.WorldName)
+		if world == nil {
+			continue
+		}
+
+		listeners := world.getPlayersByPosition(request.Position, 50)
 		msg := events.GetPlaySoundEventPayload(request.Resource, request.Position, request.Volume)
 
 		for _, listener := range listeners {
@@ -7,13 +7,47 @@ import (
 )
 
 func (s *TCPClientsState) ProcessReceivedActions(client *types.TCPClient, action *actionpb.Action) {
+	// Account actions come before the player is in the world
+	switch act := action.Action.(type) {
+	case *actionpb.Action_Login:
+		ActionLogin(client, act.Login)
+		return
+	case *actionpb.Action_CreateCharacter:
+		ActionCreateCharacter(client, act.CreateCharacter)
+		return
+	case *actionpb.Action_SelectCharacter:
+		ActionSelectCharacter(client, act.SelectCharacter)
+		return
+	}
+
+	world, obj, err := Worlds.findObject(client.UUID)
+	if err != nil {
+		fmt.Println("Error getting object from world")
+		return
+	}
+
+	// A dead player can only choose where to respawn
+	if obj.IsDead() {
+		if act, ok := action.Action.(*actionpb.Action_Respawn); ok {
+			ActionRespawn(world, obj, act.Respawn)
+		}
+		return
+	}
+
+	// A stunned player can't act until the stun wears off
+	if obj.IsStunned() {
+		return
+	}
+
 	switch act := action.Action.(type) {
 	case *actionpb.Action_Interact:
-		ActionInteract(s.world, client, act.Interact)
+		ActionInteract(world, client, act.Interact)
 	case *actionpb.Action_InteractWith:
-		ActionInteractWith(s.world, client, act.InteractWith)
+		ActionInteractWith(world, client, act.InteractWith)
 	case *actionpb.Action_Animation:
-		ActionAnimation(s.world, client, act.Animation)
+		ActionAnimation(world, client, act.Animation)
+	case *actionpb.Action_PvpFlag:
+		ActionPvPFlag(world, obj, act.PvpFlag)
 	default:
 		fmt.Printf("Unknown action type received %+v\n", action)
 	}
@@ -3,13 +3,13 @@ package gameserver
 import (
 	"bufio"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"math/rand"
 	"net"
 	"os"
-	"server/entity"
 	"server/events"
 	"server/proto/actionpb"
 	"server/proto/objectpb"
@@ -83,8 +83,8 @@ func (s *TCPClientsState) handleConnection(conn net.Conn) {
 
 	fmt.Println("New connection", connectionId, clientUUID)
 
+	// The player is spawned after login
 	s.addClient(clientUUID, &conn)
-	go s.spawnPlayer(clientUUID)
 
 	client := s.getClient(clientUUID)
 
@@ -153,7 +153,12 @@ func (s *TCPClientsState) removeClient(uuid string) {
 		return
 	}
 
-	s.world.removeObject(client.UUID)
+	if world, obj, err := Worlds.findObject(client.UUID); err == nil {
+		if client.Character != "" {
+			savePlayer(client, obj)
+		}
+		world.removeObject(client.UUID)
+	}
 
 	close(client.Send)
 	client.Writer.Flush()
@@ -170,6 +175,42 @@ func (s *TCPClientsState) removeClient(uuid string) {
 	delete(s.clients, uuid)
 }
 
+func (s *TCPClientsState) setAccount(client *types.TCPClient, account string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if client.Account != "" {
+		return errors.New("already logged in")
+	}
+
+	if !accountNameRegexp.MatchString(account) {
+		return errors.New("invalid account name")
+	}
+
+	for _, other := range s.clients {
+		if other.Account == account {
+			return errors.New("account is already in game")
+		}
+	}
+
+	client.Account = account
+
+	return nil
+}
+
+func (s *TCPClientsState) setCharacter(client *types.TCPClient, name string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if client.Character != "" {
+		return errors.New("character is already in game")
+	}
+
+	client.Character = name
+
+	return nil
+}
+
 func processSenderChannel() {
 	for params := range SenderChannel {
 		client, ok := TCPState.clients[params.UUID]
@@ -231,54 +272,62 @@ func (c *TCPClientsState) testMessageTick() {
 	}
 }
 
-func (c *TCPClientsState) spawnPlayer(uuid string) {
+func (c *TCPClientsState) spawnPlayer(uuid string, record *PlayerRecord) {
 	connection, ok := c.clients[uuid]
 	if !ok {
 		return
 	}
 
-	fmt.Println("Spawning player", uuid)
-
-	slots := make(map[string]entity.HumanSlot)
-	// MaleHair2 MilCut
-	slots["Hair"] = entity.HumanSlot{Recipe: "MilCut", Color: "#000000"}
-	slots["Beard"] = entity.HumanSlot{Recipe: "MaleBeard1", Color: "#FFFFFF"}
-	//slots["Legs"] = types.HumanSlot{Recipe: "MalePants"}
-	slots["Legs"] = entity.HumanSlot{Recipe: "MaleSweatPants_Recipe", Color: "#000000"}
-	slots["Feet"] = entity.HumanSlot{Recipe: "TallShoes_Black_Recipe"}
-	//slots["Chest"] = types.HumanSlot{Recipe: "MaleChallengerTorso"}
-	slots["Chest"] = entity.HumanSlot{Recipe: "MaleShirt2", Color: "#CACACA"}
-	// slots["Cape"] = &actors.HumanSlot{Recipe: "CapeBasic"}
-
-	teleport := c.world.getTeleport("main")
-	if teleport == nil {
-		fmt.Println("Teleport not found")
-		return
-	}
+	fmt.Println("Spawning player", uuid, record.Account, record.Name)
 
 	playerObject := &types.GameObject{
-		Entity: entity.Entity{
-			Name:      "Player",
-			Speed:     2,
-			Health:    200,
-			MaxHealth: 200,
-			HumanCharacter: &entity.HumanCharacter{
-				Gender: "male",
-				Slots:  slots,
-			},
-			EquippedItems: &entity.EquippedItems{
-				RightHand: entity.GetItem("dragon_axe"),
-			},
-		},
-		UUID:     connection.UUID,
-		Type:     types.ObjectTypePlayer,
-		Position: types.Vector3{X: teleport.Position.X, Y: teleport.Position.Y, Z: teleport.Position.Z},
-		Rotation: types.Vector3{X: 0, Y: teleport.Rotation.Y, Z: 0},
+		Entity: getPlayerEntity(record),
+		UUID:   connection.UUID,
+		Type:   types.ObjectTypePlayer,
+
+		UnlockedRespawns: record.Respawns,
 	}
 
-	c.world.addObject(playerObject)
-	c.world.updateNeighbors(playerObject)
-	c.world.updateNeighborsNearObject(playerObject)
+	// Instances do not survive the logout, such players and dead ones start at the main teleport
+	world := Worlds.get(record.WorldName)
+	if world != nil && Instances.isInstance(world.Name) {
+		world = nil
+	}
+
+	if world != nil && record.Health > 0 {
+		playerObject.Position = record.Position
+		playerObject.Rotation = record.Rotation
+	} else {
+		world = c.world
+
+		teleport := world.getTeleport("main")
+		if teleport == nil {
+			fmt.Println("Teleport not found")
+			return
+		}
+
+		playerObject.Position = world.getArrivalPosition(teleport)
+		playerObject.Rotation = types.Vector3{X: 0, Y: teleport.Rotation.Y, Z: 0}
+		playerObject.ArrivalTeleport = teleport.Name
+		playerObject.Entity.Health = playerObject.Entity.MaxHealth
+	}
+
+	world.addObject(playerObject)
+	world.updateNeighbors(playerObject)
+	world.updateNeighborsNearObject(playerObject)
+
+	if world != c.world {
+		c.sendToClient(uuid, events.GetTeleportEventPayload(uuid, playerObject.Position, playerObject.Rotation, world.Level))
+	}
+
+	c.sendWorldState(playerObject)
+	c.sendToClient(uuid, Clock.getPayload())
+	c.sendToClient(uuid, world.zoneAt(playerObject.Position.X, playerObject.Position.Z).getWeatherPayload())
+}
+
+// sendWorldState sends the surroundings to the player and the player to the nearby players
+func (c *TCPClientsState) sendWorldState(playerObject *types.GameObject) {
+	uuid := playerObject.UUID
 
 	// Send all objects to the new player
 	mapObjectsBatch := &objectpb.ObjectStateBatch{ObjectStates: []*objectpb.ObjectState{}}
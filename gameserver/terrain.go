@@ -0,0 +1,143 @@
+package gameserver
+
+import (
+	"math"
+	"server/config"
+	"server/types"
+)
+
+const MAX_BELOW_GROUND float64 = 0.5
+
+// Terrain answers ground queries from the level heightmap.
+// The terrain is centered on the world origin (same as the navigation grid offset),
+// heightmap rows go along Z and columns along X like Unity TerrainData.GetHeights.
+type Terrain struct {
+	data    TerrainData
+	width   float64 // X size
+	length  float64 // Z size
+	height  float64 // Y size, heightmap values are normalized to it
+	originX float64
+	originZ float64
+}
+
+func NewTerrain(data TerrainData) *Terrain {
+	if len(data.size) < 3 || len(data.heightmap) < 2 || len(data.heightmap[0]) < 2 {
+		return nil
+	}
+
+	return &Terrain{
+		data:    data,
+		width:   float64(data.size[0]),
+		height:  float64(data.size[1]),
+		length:  float64(data.size[2]),
+		originX: -float64(data.size[0]) / 2,
+		originZ: -float64(data.size[2]) / 2,
+	}
+}
+
+// toGrid converts world X/Z to fractional sample coordinates of a map with the given resolution
+func (t *Terrain) toGrid(x, z float64, columns, rows int) (float64, float64) {
+	column := (x - t.originX) / t.width * float64(columns-1)
+	row := (z - t.originZ) / t.length * float64(rows-1)
+
+	column = math.Max(0, math.Min(column, float64(columns-1)))
+	row = math.Max(0, math.Min(row, float64(rows-1)))
+
+	return column, row
+}
+
+func (t *Terrain) sample(row, column int) float64 {
+	return float64(t.data.heightmap[row][column]) * t.height
+}
+
+// HeightAt returns bilinear interpolated ground height
+func (t *Terrain) HeightAt(x, z float64) float64 {
+	rows := len(t.data.heightmap)
+	columns := len(t.data.heightmap[0])
+	column, row := t.toGrid(x, z, columns, rows)
+
+	c0 := int(math.Floor(column))
+	r0 := int(math.Floor(row))
+	c1 := min(c0+1, columns-1)
+	r1 := min(r0+1, rows-1)
+	fc := column - float64(c0)
+	fr := row - float64(r0)
+
+	h0 := t.sample(r0, c0)*(1-fc) + t.sample(r0, c1)*fc
+	h1 := t.sample(r1, c0)*(1-fc) + t.sample(r1, c1)*fc
+
+	return h0*(1-fr) + h1*fr
+}
+
+// NormalAt returns the unit surface normal using central differences over one heightmap cell
+func (t *Terrain) NormalAt(x, z float64) types.Vector3 {
+	stepX := t.width / float64(len(t.data.heightmap[0])-1)
+	stepZ := t.length / float64(len(t.data.heightmap)-1)
+
+	dx := (t.HeightAt(x+stepX, z) - t.HeightAt(x-stepX, z)) / (2 * stepX)
+	dz := (t.HeightAt(x, z+stepZ) - t.HeightAt(x, z-stepZ)) / (2 * stepZ)
+
+	length := math.Sqrt(dx*dx + 1 + dz*dz)
+
+	return types.Vector3{X: -dx / length, Y: 1 / length, Z: -dz / length}
+}
+
+// SurfaceAt returns the surface type of the layer with the biggest weight in the alphamap cell
+func (t *Terrain) SurfaceAt(x, z float64) types.SurfaceType {
+	if len(t.data.alphamaps) == 0 || len(t.data.alphamaps[0]) == 0 {
+		return types.SurfaceDefault
+	}
+
+	column, row := t.toGrid(x, z, len(t.data.alphamaps[0]), len(t.data.alphamaps))
+	weights := t.data.alphamaps[int(math.Round(row))][int(math.Round(column))]
+
+	dominant := -1
+	for layer, weight := range weights {
+		if dominant == -1 || weight > weights[dominant] {
+			dominant = layer
+		}
+	}
+
+	if dominant == -1 || dominant >= len(t.data.alphamapTextureIndices) {
+		return types.SurfaceDefault
+	}
+
+	if surface, ok := config.TerrainLayerSurfaces[t.data.alphamapTextureIndices[dominant]]; ok {
+		return surface
+	}
+
+	return types.SurfaceDefault
+}
+
+// getGroundHeight returns the terrain height under the position, levels without terrain keep the position height
+func (w *World) getGroundHeight(position types.Vector3) float64 {
+	if w.terrain == nil {
+		return position.Y
+	}
+
+	return w.terrain.HeightAt(position.X, position.Z)
+}
+
+func (w *World) getGroundNormal(position types.Vector3) types.Vector3 {
+	if w.terrain == nil {
+		return types.Vector3{X: 0, Y: 1, Z: 0}
+	}
+
+	return w.terrain.NormalAt(position.X, position.Z)
+}
+
+func (w *World) getSurface(position types.Vector3) types.SurfaceType {
+	if w.terrain == nil {
+		return types.SurfaceDefault
+	}
+
+	return w.terrain.SurfaceAt(position.X, position.Z)
+}
+
+// getArrivalPosition keeps teleports placed above the ground (floors, bridges) and lifts the ones sunk into the terrain
+func (w *World) getArrivalPosition(teleport *LevelTeleport) types.Vector3 {
+	position := teleport.Position
+	position.Y = math.Max(position.Y, w.getGroundHeight(position))
+
+	return position
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"server/config"
+	"server/events"
 	"server/proto/actionpb"
+	"server/types"
 	"sync"
 	"time"
 
@@ -14,6 +17,10 @@ import (
 type UDPClient struct {
 	Addr *net.UDPAddr
 	Conn *net.UDPConn
+	RTT  time.Duration // Smoothed round trip time, 0 until measured
+
+	PingTimestamp int64      // Timestamp of the outstanding ping, the pong has to echo it
+	PingSentAt    *time.Time // nil when no ping is outstanding
 }
 
 type UDPClientsState struct {
@@ -30,6 +37,8 @@ type UpdateTransform struct {
 const (
 	udpPort = ":8000"
 	bufSize = 1024
+
+	STUN_CORRECTION_DISTANCE float64 = 0.1 // Stunned player reported further from the server position is moved back
 )
 
 var UpdateTransformChan = make(chan UpdateTransform)
@@ -78,18 +87,43 @@ func StartUDPServer() {
 		case *actionpb.Action_Ping:
 			ping := action.GetPing()
 			UDPState.addClient(ping.UUID, clientAddr, conn)
+			UDPState.sendToClient(ping.UUID, events.GetPongPayload(ping.UUID, ping.Timestamp))
+
+		case *actionpb.Action_Pong:
+			pong := action.GetPong()
+			UDPState.onPong(pong.UUID, clientAddr, pong.Timestamp)
 
 		case *actionpb.Action_Transform:
 			transform := action.GetTransform()
 
-			obj, err := W.getObject(transform.UUID)
+			world, obj, err := Worlds.findObject(transform.UUID)
 			if err != nil {
 				log.Printf("Object not found: %s", transform.UUID)
 				continue
 			}
 
-			W.Lock()
+			// Corpses don't move
+			if obj.IsDead() {
+				continue
+			}
+
+			// Stunned players don't move, the client which kept walking is moved back to the server position
+			if obj.IsStunned() {
+				reported := types.Vector3{X: float64(transform.Position.X), Y: float64(transform.Position.Y), Z: float64(transform.Position.Z)}
+
+				world.RLock()
+				position, rotation := obj.Position, obj.Rotation
+				world.RUnlock()
+
+				if distance(position, reported) > STUN_CORRECTION_DISTANCE {
+					TeleportObjectChannel <- &types.TeleportObject{Object: obj, Position: position, Rotation: rotation}
+				}
+				continue
+			}
+
+			world.Lock()
 
+			prevPosition := obj.Position
 			obj.Position.X = float64(transform.Position.X)
 			obj.Position.Y = float64(transform.Position.Y)
 			obj.Position.Z = float64(transform.Position.Z)
@@ -97,8 +131,13 @@ func StartUDPServer() {
 			obj.Rotation.Y = float64(transform.Rotation.Y)
 			obj.Rotation.Z = float64(transform.Rotation.Z)
 			obj.Speed = float32(transform.Speed)
+			isCorrected := world.validatePlayerPosition(obj, prevPosition)
 
-			W.Unlock()
+			world.Unlock()
+
+			if isCorrected {
+				TeleportObjectChannel <- &types.TeleportObject{Object: obj, Position: obj.Position, Rotation: obj.Rotation}
+			}
 
 			nextStepTime := time.Now().Add(40 * time.Millisecond)
 
@@ -132,6 +171,65 @@ func (c *UDPClientsState) addClient(uuid string, addr *net.UDPAddr, conn *net.UD
 	}
 }
 
+// registerPing remembers when the ping was sent, the client only echoes the timestamp back
+func (c *UDPClientsState) registerPing(uuid string, now time.Time) (int64, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	client := c.clients[uuid]
+	if client == nil {
+		return 0, false
+	}
+
+	client.PingTimestamp = now.UnixMilli()
+	client.PingSentAt = &now
+
+	return client.PingTimestamp, true
+}
+
+// onPong measures the round trip of the outstanding ping. The pong is accepted only from the address
+// the client is registered with, other senders can't change its rewind window.
+func (c *UDPClientsState) onPong(uuid string, addr *net.UDPAddr, timestamp int64) {
+	c.Lock()
+	defer c.Unlock()
+
+	client := c.clients[uuid]
+	if client == nil || client.PingSentAt == nil || timestamp != client.PingTimestamp {
+		return
+	}
+
+	if !client.Addr.IP.Equal(addr.IP) || client.Addr.Port != addr.Port {
+		return
+	}
+
+	sample := time.Since(*client.PingSentAt)
+	client.PingSentAt = nil
+
+	if client.RTT == 0 {
+		client.RTT = sample
+		return
+	}
+
+	if client.RTT == 0 {
+		client.RTT = sample
+		return
+	}
+
+	// Smoothed like TCP does, one slow packet doesn't open a big rewind window
+	client.RTT = (client.RTT*7 + sample) / 8
+}
+
+func (c *UDPClientsState) getRTT(uuid string) time.Duration {
+	c.RLock()
+	defer c.RUnlock()
+
+	if client := c.clients[uuid]; client != nil {
+		return client.RTT
+	}
+
+	return 0
+}
+
 func (c *UDPClientsState) removeClient(uuid string) {
 	c.Lock()
 	defer c.Unlock()
@@ -159,6 +257,25 @@ func (c *UDPClientsState) sendToClient(uuid string, event *actionpb.Action) {
 	_, _ = client.Conn.WriteToUDP(data, client.Addr)
 }
 
+// ProcessRTTProbes pings every client to measure the round trip time for lag compensation
+func ProcessRTTProbes() {
+	ticker := time.NewTicker(config.RTTProbeInterval)
+	for range ticker.C {
+		UDPState.RLock()
+		uuids := make([]string, 0, len(UDPState.clients))
+		for uuid := range UDPState.clients {
+			uuids = append(uuids, uuid)
+		}
+		UDPState.RUnlock()
+
+		for _, uuid := range uuids {
+			if timestamp, ok := UDPState.registerPing(uuid, time.Now()); ok {
+				UDPState.sendToClient(uuid, events.GetPingPayload(uuid, timestamp))
+			}
+		}
+	}
+}
+
 func processTransformsUpdates() {
 	for update := range UpdateTransformChan {
 		client := UDPState.clients[update.clientUUID]
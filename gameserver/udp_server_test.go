@@ -0,0 +1,45 @@
+package gameserver
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestOnPongAcceptsOnlyTheOutstandingPingFromTheClientAddress(t *testing.T) {
+	state := &UDPClientsState{clients: map[string]*UDPClient{}}
+	addr := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}
+	state.addClient("player", addr, nil)
+
+	spoofed := &net.UDPAddr{IP: net.IPv4(10, 0, 0, 2), Port: 5000}
+	old := time.Now().Add(-time.Hour).UnixMilli()
+
+	// Pong without a ping
+	state.onPong("player", addr, old)
+	if rtt := state.getRTT("player"); rtt != 0 {
+		t.Fatalf("unsolicited pong set the RTT to %s", rtt)
+	}
+
+	timestamp, ok := state.registerPing("player", time.Now().Add(-50*time.Millisecond))
+	if !ok {
+		t.Fatal("ping to a registered client is not sent")
+	}
+
+	state.onPong("player", spoofed, timestamp)
+	state.onPong("player", addr, old)
+	if rtt := state.getRTT("player"); rtt != 0 {
+		t.Fatalf("spoofed or stale pong set the RTT to %s", rtt)
+	}
+
+	state.onPong("player", addr, timestamp)
+	rtt := state.getRTT("player")
+	if rtt < 50*time.Millisecond || rtt > time.Second {
+		t.Fatalf("RTT = %s, want about 50ms", rtt)
+	}
+
+	// The ping is answered, repeating the pong changes nothing
+	state.onPong("player", addr, timestamp)
+	if repeated := state.getRTT("player"); repeated != rtt {
+		t.Errorf("repeated pong changed the RTT from %s to %s", rtt, repeated)
+	}
+}
@@ -0,0 +1,124 @@
+package gameserver
+
+import (
+	"fmt"
+	"math/rand"
+	"server/config"
+	"server/events"
+	"server/proto/actionpb"
+	"server/types"
+	"time"
+)
+
+type WeatherType = string
+
+const (
+	WeatherClear WeatherType = "clear"
+	WeatherRain  WeatherType = "rain"
+	WeatherFog   WeatherType = "fog"
+	WeatherStorm WeatherType = "storm"
+)
+
+// Gameplay modifiers of the weather, types not listed keep the value
+var weatherAgroModifiers = map[WeatherType]float64{
+	WeatherFog:   0.5,
+	WeatherStorm: 0.7,
+	WeatherRain:  0.85,
+}
+
+var weatherSoundVolumeModifiers = map[WeatherType]float32{
+	WeatherRain:  0.7,
+	WeatherStorm: 0.5,
+}
+
+// Weather is the state of a zone, the client blends from the previous type during the transition
+type Weather struct {
+	Type           WeatherType
+	PreviousType   WeatherType
+	TransitionEnd  time.Time
+	NextChangeTime time.Time
+}
+
+func newWeather() *Weather {
+	weather := &Weather{Type: WeatherClear, PreviousType: WeatherClear}
+	weather.scheduleNextChange()
+
+	return weather
+}
+
+func (w *Weather) scheduleNextChange() {
+	interval := config.WeatherChangeMaxInterval - config.WeatherChangeMinInterval
+	w.NextChangeTime = time.Now().Add(config.WeatherChangeMinInterval + time.Duration(rand.Int63n(int64(interval)+1)))
+}
+
+func pickWeather() WeatherType {
+	total := 0
+	for _, weight := range config.WeatherWeights {
+		total += weight
+	}
+
+	if total == 0 {
+		return WeatherClear
+	}
+
+	roll := rand.Intn(total)
+	for weatherType, weight := range config.WeatherWeights {
+		if roll < weight {
+			return weatherType
+		}
+		roll -= weight
+	}
+
+	return WeatherClear
+}
+
+func (z *Zone) getWeather() Weather {
+	z.RLock()
+	defer z.RUnlock()
+
+	return *z.weather
+}
+
+func (z *Zone) getWeatherPayload() *actionpb.Action {
+	weather := z.getWeather()
+	transition := time.Until(weather.TransitionEnd).Seconds()
+	if transition < 0 {
+		transition = 0
+	}
+
+	return events.GetWeatherPayload(z.Name, weather.Type, weather.PreviousType, float32(transition))
+}
+
+// weatherTick starts a transition to the next weather when it is time and notifies players in the zone
+func (z *Zone) weatherTick() {
+	z.Lock()
+	if time.Now().Before(z.weather.NextChangeTime) {
+		z.Unlock()
+		return
+	}
+
+	nextType := pickWeather()
+	z.weather.PreviousType = z.weather.Type
+	z.weather.Type = nextType
+	z.weather.TransitionEnd = time.Now().Add(config.WeatherTransition)
+	z.weather.scheduleNextChange()
+	isChanged := z.weather.PreviousType != nextType
+	z.Unlock()
+
+	if !isChanged {
+		return
+	}
+
+	fmt.Printf("Weather in %s %s: %s\n", z.world.Name, z.Name, nextType)
+
+	msg := z.getWeatherPayload()
+	for _, obj := range z.getObjects() {
+		if obj.Type == types.ObjectTypePlayer {
+			TCPState.sendToClient(obj.UUID, msg)
+		}
+	}
+}
+
+func (w *World) getWeatherAt(position types.Vector3) WeatherType {
+	return w.zoneAt(position.X, position.Z).getWeather().Type
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"server/config"
 	"server/entity"
 	"server/types"
 	"sort"
@@ -17,9 +18,15 @@ const AREA_OF_INTEREST float64 = 30 // 400
 
 type World struct {
 	sync.RWMutex
-	Octree    *types.Octree
-	objects   map[string]*types.GameObject
-	teleports []*LevelTeleport
+	Name        string
+	Level       string // Level the world is loaded from, differs from the name for instances
+	zones       []*Zone
+	objects     map[string]*types.GameObject
+	objectZones map[string]*Zone
+	teleports   []*LevelTeleport
+	regions     []*LevelRegion
+	terrain     *Terrain
+	stop        chan struct{}
 }
 
 type LookedAtObject struct {
@@ -27,24 +34,99 @@ type LookedAtObject struct {
 	Distance   float64
 }
 
-func NewWorld(size float64) *World {
-	oct := types.CreateOctree(
-		types.Vector3f{-size, -size, -size},
-		types.Vector3f{size, size, size},
-	)
-	return &World{
-		Octree:  oct,
-		objects: make(map[string]*types.GameObject),
+func NewWorld(name string, size float64) *World {
+	w := &World{
+		Name:        name,
+		Level:       name,
+		objects:     make(map[string]*types.GameObject),
+		objectZones: make(map[string]*Zone),
+		stop:        make(chan struct{}),
 	}
+
+	for _, rect := range config.WorldZones {
+		w.zones = append(w.zones, newZone(w, rect, size))
+	}
+
+	if len(w.zones) == 0 {
+		rect := config.ZoneRect{Name: "default", MinX: -size, MinZ: -size, MaxX: size, MaxZ: size}
+		w.zones = append(w.zones, newZone(w, rect, size))
+	}
+
+	return w
+}
+
+func (w *World) startZones() {
+	for _, zone := range w.zones {
+		go zone.run()
+	}
+}
+
+func (w *World) stopZones() {
+	close(w.stop)
+}
+
+func (w *World) getPlayersCount() int {
+	w.RLock()
+	defer w.RUnlock()
+
+	count := 0
+	for _, obj := range w.objects {
+		if obj.Type == types.ObjectTypePlayer {
+			count++
+		}
+	}
+
+	return count
+}
+
+// zoneAt returns the zone containing the position or the closest one
+func (w *World) zoneAt(x, z float64) *Zone {
+	var closest *Zone
+	minDistance := math.MaxFloat64
+
+	for _, zone := range w.zones {
+		if zone.contains(x, z) {
+			return zone
+		}
+
+		dist := zone.distanceTo(x, z)
+		if dist < minDistance {
+			minDistance = dist
+			closest = zone
+		}
+	}
+
+	return closest
+}
+
+// elementsIn collects objects from every zone the box overlaps, so observers near a border see the neighbour zone
+func (w *World) elementsIn(box types.Box) []*types.GameObject {
+	elements := make([]*types.GameObject, 0)
+
+	for _, zone := range w.zones {
+		if zone.intersects(box) {
+			elements = append(elements, zone.elementsIn(box)...)
+		}
+	}
+
+	return elements
 }
 
 func (w *World) addObject(obj *types.GameObject) {
 	w.Lock()
 	defer w.Unlock()
 
-	node := w.Octree.Add(obj, types.Vector3f{obj.Position.X, obj.Position.Y, obj.Position.Z})
-	obj.Node = node
+	zone := w.zoneAt(obj.Position.X, obj.Position.Z)
+
+	// Respawned NPC can come back in another zone than the one it died in
+	if prevZone, ok := w.objectZones[obj.UUID]; ok && prevZone != zone {
+		prevZone.remove(obj)
+	}
+
+	obj.WorldName = w.Name
+	zone.add(obj)
 	w.objects[obj.UUID] = obj
+	w.objectZones[obj.UUID] = zone
 }
 
 func (w *World) getObject(uuid string) (*types.GameObject, error) {
@@ -60,18 +142,22 @@ func (w *World) getObject(uuid string) (*types.GameObject, error) {
 	return object, nil
 }
 
-func (w *World) removeObject(uuid string) {
+// removeObject returns false when the object was already removed
+func (w *World) removeObject(uuid string) bool {
 	w.Lock()
 
 	obj, ok := w.objects[uuid]
 	if !ok {
 		w.Unlock()
-		return
+		return false
 	}
 
 	neighbors := obj.Neighbors
 	delete(w.objects, obj.UUID)
-	w.Octree.RemoveUsing(*obj, obj.Node)
+	if zone, ok := w.objectZones[obj.UUID]; ok {
+		zone.remove(obj)
+		delete(w.objectZones, obj.UUID)
+	}
 	w.Unlock()
 
 	// update neighbors
@@ -82,6 +168,8 @@ func (w *World) removeObject(uuid string) {
 
 		w.updateNeighbors(neighbor)
 	}
+
+	return true
 }
 
 func (w *World) hideObject(uuid string) {
@@ -94,7 +182,9 @@ func (w *World) hideObject(uuid string) {
 	}
 
 	neighbors := obj.Neighbors
-	w.Octree.RemoveUsing(*obj, obj.Node)
+	if zone, ok := w.objectZones[obj.UUID]; ok {
+		zone.hide(obj)
+	}
 	w.Unlock()
 
 	for _, neighbor := range neighbors {
@@ -107,17 +197,37 @@ func (w *World) hideObject(uuid string) {
 }
 
 func (w *World) moveObjectTo(obj *types.GameObject) {
-	w.RLock()
-	w.Octree.RemoveUsing(*obj, obj.Node)
-	obj.Node = w.Octree.Add(obj, types.Vector3f{obj.Position.X, obj.Position.Y, obj.Position.Z})
-	w.RUnlock()
+	w.Lock()
+	prevZone := w.objectZones[obj.UUID]
+	zone := w.zoneAt(obj.Position.X, obj.Position.Z)
+	var weatherZone *Zone
+
+	if prevZone == zone {
+		zone.move(obj)
+	} else {
+		// Handoff to the neighbour zone, neighbors are recalculated across zones so clients don't notice
+		if prevZone != nil {
+			prevZone.remove(obj)
+		}
+		zone.add(obj)
+		w.objectZones[obj.UUID] = zone
+
+		if obj.Type == types.ObjectTypePlayer {
+			weatherZone = zone
+		}
+	}
+	w.Unlock()
+
+	// Sent outside of the world lock, the client writer may block
+	if weatherZone != nil {
+		TCPState.sendToClient(obj.UUID, weatherZone.getWeatherPayload())
+	}
+
 	w.updateNeighbors(obj)
 }
 
 func (w *World) getObjectsAt(position types.Vector3f) []*types.GameObject {
-	w.Lock()
-	defer w.Unlock()
-	return w.Octree.ElementsAt(types.Vector3f(position))
+	return w.zoneAt(position[0], position[2]).elementsAt(position)
 }
 
 func (w *World) updateNeighbors(obj *types.GameObject) {
@@ -132,7 +242,7 @@ func (w *World) updateNeighbors(obj *types.GameObject) {
 	boxMax := types.Vector3f{center.X + radius, center.Y + radius, center.Z + radius}
 	box := types.Box{Min: boxMin, Max: boxMax}
 
-	elements := w.Octree.ElementsIn(box)
+	elements := w.elementsIn(box)
 
 	for _, data := range elements {
 		if obj.UUID != data.UUID {
@@ -151,7 +261,7 @@ func (w *World) updateNeighborsNearObject(obj *types.GameObject) {
 	box := types.Box{Min: boxMin, Max: boxMax}
 
 	w.Lock()
-	elements := w.Octree.ElementsIn(box)
+	elements := w.elementsIn(box)
 	w.Unlock()
 
 	for _, data := range elements {
@@ -170,7 +280,7 @@ func (w *World) getPlayersByPosition(position types.Vector3, radius float64) []*
 	boxMax := types.Vector3f{center.X + radius, center.Y + radius, center.Z + radius}
 	box := types.Box{Min: boxMin, Max: boxMax}
 
-	elements := w.Octree.ElementsIn(box)
+	elements := w.elementsIn(box)
 
 	for _, data := range elements {
 		if data.Type == types.ObjectTypePlayer {
@@ -188,7 +298,11 @@ func (w *World) updateObjectVariation(obj *types.GameObject, variationIndex int3
 }
 
 func (w *World) broadcastSound(resource string, position types.Vector3, volume float32) {
-	BroadcastSoundChannel <- &types.BroadcastSound{Resource: resource, Position: position, Volume: volume}
+	if modifier, ok := weatherSoundVolumeModifiers[w.getWeatherAt(position)]; ok {
+		volume *= modifier
+	}
+
+	BroadcastSoundChannel <- &types.BroadcastSound{Resource: resource, Position: position, Volume: volume, WorldName: w.Name}
 }
 
 func (w *World) interactQueue(object *types.GameObject) {
@@ -202,6 +316,8 @@ func (w *World) transformObjectRotation(object *types.GameObject, rotation types
 
 func (w *World) dropItemOnGround(entity entity.Entity, position types.Vector3) {
 	uuid, _ := uuid.NewUUID()
+	position = w.pushOutOfColliders(position, LOOT_RADIUS)
+	position.Y = w.getGroundHeight(position)
 
 	destroyTime := time.Now().Add(time.Duration(10) * time.Second)
 	object := &types.GameObject{
@@ -218,15 +334,16 @@ func (w *World) dropItemOnGround(entity entity.Entity, position types.Vector3) {
 	SpawnObjectChannel <- &types.SpawnObject{Object: object}
 }
 
-func (w *World) npcWalkTick() {
-	for _, npc := range w.objects {
+func (w *World) npcWalkTick(objects []*types.GameObject) {
+	for _, npc := range objects {
 		if npc.Type != types.ObjectTypeNPC || npc.IsDead() {
 			continue
 		}
 
 		// Destination set
 		if npc.NextDestinationTime == nil || time.Now().After(*npc.NextDestinationTime) {
-			if len(npc.Path) == 0 && len(npc.Waypoints) > 0 && npc.AttackTargetUUID == "" && !npc.IsReturningInProgress {
+			isResting := npc.Entity.GetBehaviour(Clock.TimeOfDay()).IsResting
+			if len(npc.Path) == 0 && len(npc.Waypoints) > 0 && npc.AttackTargetUUID == "" && !npc.IsReturningInProgress && !isResting {
 				waypoint := npc.GetNextRandomWaypoint()
 
 				if waypoint != nil {
@@ -241,7 +358,18 @@ func (w *World) npcWalkTick() {
 			}
 		}
 
-		if len(npc.Path) > 0 {
+		if npc.IsReturningInProgress {
+			w.npcEvadeHeal(npc)
+
+			if len(npc.Path) == 0 || w.npcEvadeTimedOut(npc) {
+				w.npcReturnHome(npc)
+				continue
+			}
+		}
+
+		if len(npc.Path) > 0 && !npc.IsStunned() {
+			npc.Path[0] = w.pushPathNodeOut(npc.Path[0])
+
 			w.RLock()
 			changed, finished := npc.MoveNPCWithWaypoints()
 			w.RUnlock()
@@ -251,7 +379,7 @@ func (w *World) npcWalkTick() {
 			}
 
 			if finished && npc.IsReturningInProgress {
-				npc.IsReturningInProgress = false
+				w.npcEvadeFinish(npc)
 			}
 
 			if changed {
@@ -262,8 +390,28 @@ func (w *World) npcWalkTick() {
 	}
 }
 
-func (w *World) npcRespawnTick() {
-	for _, object := range w.objects {
+const FOOTSTEP_DISTANCE float64 = 1.2 // Meters walked between footstep sounds
+
+// onGroundStep updates the surface under the object and plays the footstep sound of it every few meters
+func (w *World) onGroundStep(object *types.GameObject) {
+	object.Surface = w.getSurface(object.Position)
+
+	if object.LastStepPosition != nil && distance2D(*object.LastStepPosition, object.Position) < FOOTSTEP_DISTANCE {
+		return
+	}
+
+	// The first update after spawn only remembers the position
+	isFirstStep := object.LastStepPosition == nil
+	stepPosition := object.Position
+	object.LastStepPosition = &stepPosition
+
+	if sound, ok := types.SurfaceFootstepSounds[object.Surface]; ok && !isFirstStep {
+		w.broadcastSound(sound, object.Position, 0.3)
+	}
+}
+
+func (w *World) npcRespawnTick(objects []*types.GameObject) {
+	for _, object := range objects {
 		if object.Type != types.ObjectTypeNPC || object.NextSpawnTime == nil {
 			continue
 		}
@@ -272,16 +420,20 @@ func (w *World) npcRespawnTick() {
 			continue
 		}
 
-		W.Lock()
+		if object.Entity.GetBehaviour(Clock.TimeOfDay()).IsHidden {
+			continue
+		}
+
+		w.Lock()
 		object.Entity.Health = object.Entity.MaxHealth
 		object.NextSpawnTime = nil
 		object.Position = object.PositionSpawn
 		object.Rotation = object.RotationSpawn
 		object.SetNextTravelTime()
-		W.Unlock()
+		w.Unlock()
 
-		W.addObject(object)
-		W.updateNeighbors(object)
+		w.addObject(object)
+		w.updateNeighbors(object)
 
 		SpawnObjectChannel <- &types.SpawnObject{Object: object}
 	}
@@ -296,8 +448,8 @@ func (w *World) npcResetCurrentAnimation(object *types.GameObject) {
 	object.CurrentAnimation = nil
 }
 
-func (w *World) npcAttackTick() {
-	for _, object := range w.objects {
+func (w *World) npcAttackTick(objects []*types.GameObject) {
+	for _, object := range objects {
 		if object.Type != types.ObjectTypeNPC {
 			continue
 		}
@@ -311,6 +463,8 @@ func (w *World) npcAttackTick() {
 			continue
 		}
 
+		w.npcThreatTick(object)
+
 		if object.AttackTargetUUID != "" {
 			target, err := w.getObject(object.AttackTargetUUID)
 			distanceFromSpawn := distance(types.Vector3{X: object.Waypoints[0][0], Y: object.Waypoints[0][2], Z: object.Waypoints[0][1]}, object.Position)
@@ -318,6 +472,7 @@ func (w *World) npcAttackTick() {
 			if err != nil || target.IsDead() {
 				fmt.Println("Lost target: ", distanceFromSpawn)
 
+				object.GetThreat().Remove(object.AttackTargetUUID)
 				object.ReleaseAttack()
 
 				waypoint := object.GetNextRandomWaypoint()
@@ -327,7 +482,13 @@ func (w *World) npcAttackTick() {
 			}
 
 			dist := distance(object.Position, target.Position)
-			if dist <= *attackRange {
+			if w.npcShouldEvade(object, dist) {
+				w.npcEvade(object)
+				continue
+			}
+
+			isTargetVisible := !object.IsRangedAttack() || w.canSee(object, target)
+			if dist <= *attackRange && isTargetVisible {
 
 				object.Path = nil
 
@@ -340,37 +501,10 @@ func (w *World) npcAttackTick() {
 				targetPosition := target.Position
 				object.TargetPosition = &targetPosition
 
-				// Skip attack frame
-				if object.NextAttackTime != nil && time.Now().Before(*object.NextAttackTime) {
-					continue
+				if w.startAttack(object, target) {
+					object.AttackAttempts++
 				}
 
-				if object.IsReloadWeaponInProgress() {
-					continue
-				}
-
-				if object.IsClipEmpty() {
-					object.StartReloadWeapon()
-					UpdateAnimationChannel <- &types.Animation{Object: object, Name: "Reloading", Speed: 1}
-					continue
-				}
-
-				// Attack
-				object.DecrementWeaponClip()
-				object.AttackAttempts++
-				attackSpeed := object.GetAttackSpeed()
-
-				nextAttackTime := time.Now().Add(time.Duration(*attackSpeed*1000) * time.Millisecond)
-				object.NextAttackTime = &nextAttackTime
-
-				animation := object.GetInteractAnimation()
-				if animation != "" {
-					object.CurrentAnimation = &animation
-					UpdateAnimationChannel <- &types.Animation{Object: object, Name: animation, Speed: 1}
-				}
-
-				go w.damageWithDelay(object, target, time.Duration(*attackSpeed*200))
-
 				continue
 			}
 
@@ -378,67 +512,15 @@ func (w *World) npcAttackTick() {
 
 			if len(object.Path) == 0 {
 				fmt.Printf("NPC %s dist to target %f\n", object.Name, dist)
-				isTargetNotReached := dist > float64(object.Entity.AttackRange)
-				// TODO: check if NPC out of range from spawn
+				isTargetNotReached := dist > float64(object.Entity.AttackRange) || !isTargetVisible
 				if isTargetNotReached {
 					object.SetDestination(target.Position.X, target.Position.Z)
-
-					distanceFromSpawn := distance(*object.GetSpawnPoint(), object.Position)
-					if distanceFromSpawn > 20 {
-						object.Path = nil
-						object.IsReturningInProgress = true
-						object.ReleaseAttack()
-						object.SetDestination(object.Waypoints[0][0], object.Waypoints[0][1])
-						continue
-					}
 				}
 			}
 		}
-
-		if object.AttackTargetUUID == "" {
-			player, dist := w.findClosestPlayer(object)
-
-			if player != nil && dist < 10 {
-				object.AttackTargetUUID = player.UUID
-				object.AttackAttempts = 0
-				//object.Entity.Speed = 4
-			}
-		}
-	}
-}
-
-func (w *World) damageWithDelay(source *types.GameObject, target *types.GameObject, delay time.Duration) {
-	time.Sleep(delay)
-
-	// random damage from 10 to 50
-	isCrit := false
-	damage := source.GetAttackMaxDamage()
-	if damage == nil {
-		return
-	}
-
-	target.TakeDamage(*damage)
-
-	DamageChannel <- &types.Damage{Object: target, Amount: *damage, IsCrit: isCrit, HealthCurrent: int32(target.Entity.Health), HealthMax: int32(target.Entity.MaxHealth)}
-
-	if target.IsDead() {
-		source.AttackTargetUUID = ""
-		source.TargetPosition = nil
-		w.npcResetCurrentAnimation(source)
-
-		go w.playerIsDead(target)
 	}
 }
 
-func (w *World) playerIsDead(object *types.GameObject) {
-	time.Sleep(4 * time.Second)
-
-	teleport := w.getTeleport("main")
-	object.Position = teleport.Position
-	object.Health = object.MaxHealth
-	TeleportObjectChannel <- &types.TeleportObject{Object: object, Position: teleport.Position, Rotation: teleport.Rotation}
-}
-
 func (w *World) getTeleport(name string) *LevelTeleport {
 	for _, teleport := range w.teleports {
 		if teleport.Name == name {
@@ -448,8 +530,19 @@ func (w *World) getTeleport(name string) *LevelTeleport {
 	return nil
 }
 
-func (w *World) mapObjectVariationTick() {
-	for _, obj := range w.objects {
+// scheduleResourceRespawn grows the depleted resource back later, only on allowed ground.
+// The ground under a static object never changes, so it is checked once.
+func (w *World) scheduleResourceRespawn(obj *types.GameObject) {
+	if surface := w.getSurface(obj.Position); !obj.Entity.CanSpawnOn(surface) {
+		fmt.Printf("%s %s can't grow back on %s\n", obj.Entity.Name, obj.UUID, surface)
+		return
+	}
+
+	obj.ScheduleRespawn()
+}
+
+func (w *World) mapObjectVariationTick(objects []*types.GameObject) {
+	for _, obj := range objects {
 		if obj.NextVariation != nil && time.Now().After(obj.NextVariation.Time) {
 			if obj.NextVariation.ResetHealth {
 				obj.Entity.Health = obj.Entity.MaxHealth
@@ -460,8 +553,8 @@ func (w *World) mapObjectVariationTick() {
 	}
 }
 
-func (w *World) mapObjectDestroyTick() {
-	for _, obj := range w.objects {
+func (w *World) mapObjectDestroyTick(objects []*types.GameObject) {
+	for _, obj := range objects {
 		if obj.DestroyTime != nil && time.Now().After(*obj.DestroyTime) {
 			w.removeObject(obj.UUID)
 			DestroyObjectChannel <- &types.DestroyObject{Object: obj}
@@ -489,7 +582,8 @@ func (w *World) findClosestMapObjectByKind(gameObject *types.GameObject, kind ty
 	return closest, minDistance
 }
 
-func (w *World) findClosestPlayer(gameObject *types.GameObject) (*types.GameObject, float64) {
+// findClosestConsumable returns the closest consumable lying on the ground near the object
+func (w *World) findClosestConsumable(gameObject *types.GameObject) (*types.GameObject, float64) {
 	w.Lock()
 	defer w.Unlock()
 
@@ -497,16 +591,13 @@ func (w *World) findClosestPlayer(gameObject *types.GameObject) (*types.GameObje
 	minDistance := math.MaxFloat64
 
 	for _, point := range gameObject.Neighbors {
-		if point.Type != types.ObjectTypePlayer || point.Entity.Health <= 0 {
-			continue
-		}
-
-		dist := distance(gameObject.Position, point.Position)
-		if dist < minDistance {
-			minDistance = dist
-			closest = point
+		if point.Type == types.ObjectTypeMapObject && point.Entity.Type == entity.TypeConsumable {
+			dist := distance(gameObject.Position, point.Position)
+			if dist < minDistance {
+				minDistance = dist
+				closest = point
+			}
 		}
-
 	}
 
 	return closest, minDistance
@@ -539,6 +630,10 @@ func (w *World) findLookedAtObjects(source *types.GameObject, targetName string,
 				continue
 			}
 
+			if !w.canSee(source, point) {
+				continue
+			}
+
 			angle := math.Acos(direction.X*vectorToObject.X+direction.Y*vectorToObject.Y+direction.Z*vectorToObject.Z) * (180 / math.Pi)
 
 			if angle <= fieldOfViewAngle/2 {
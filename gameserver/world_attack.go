@@ -0,0 +1,245 @@
+package gameserver
+
+import (
+	"math"
+	"server/entity"
+	"server/types"
+	"time"
+)
+
+const ATTACK_WINDUP_RATIO float64 = 0.2 // Part of the attack cycle before the hit lands
+
+// npcLoot is dropped by every killed NPC
+var npcLoot = []string{"pistol", "health_potion"}
+
+// startAttack runs the attack state machine shared by players and NPCs:
+// cooldown, reload of an empty clip, swing animation and the delayed hit.
+// Returns false when the attacker is not ready yet.
+func (w *World) startAttack(source, target *types.GameObject) bool {
+	if source.NextAttackTime != nil && time.Now().Before(*source.NextAttackTime) {
+		return false
+	}
+
+	if source.IsReloadWeaponInProgress() || source.IsStunned() {
+		return false
+	}
+
+	if source.IsClipEmpty() {
+		source.StartReloadWeapon()
+		UpdateAnimationChannel <- &types.Animation{Object: source, Name: "Reloading", Speed: 1}
+		return false
+	}
+
+	attackSpeed := source.GetAttackSpeed()
+	if attackSpeed == nil {
+		return false
+	}
+
+	source.DecrementWeaponClip()
+
+	attackDuration := time.Duration(*attackSpeed * float64(time.Second))
+	nextAttackTime := time.Now().Add(attackDuration)
+	source.NextAttackTime = &nextAttackTime
+
+	animation := source.GetInteractAnimation()
+	if animation != "" {
+		source.CurrentAnimation = &animation
+		UpdateAnimationChannel <- &types.Animation{Object: source, Name: animation, Speed: 1}
+	}
+
+	go w.damageWithDelay(source, target, time.Duration(float64(attackDuration)*ATTACK_WINDUP_RATIO))
+
+	return true
+}
+
+func (w *World) damageWithDelay(source *types.GameObject, target *types.GameObject, delay time.Duration) {
+	time.Sleep(delay)
+
+	// The attacker died or was stunned during the windup
+	if source.IsDead() || source.IsStunned() {
+		return
+	}
+
+	// Ranged weapons fire a projectile at the position the target has now, it can still dodge
+	if source.GetAttackWeapon().ProjectileSpeed > 0 {
+		w.spawnProjectile(source, target)
+		return
+	}
+
+	w.resolveHit(source, target)
+}
+
+func (w *World) resolveHit(source, target *types.GameObject) {
+	for target, scale := range w.getAttackTargets(source, target) {
+		w.applyDamage(source, target, scale)
+	}
+}
+
+func (w *World) applyDamage(source, target *types.GameObject, scale float64) {
+	damage := Combat.Calculate(source, target)
+	if damage.Amount == 0 {
+		return
+	}
+
+	w.dealDamage(source, target, int32(math.Round(float64(damage.Amount)*scale)), damage.IsCrit)
+
+	if !target.IsDead() {
+		w.applyHitEffects(source, target)
+	}
+}
+
+// dealDamage takes the health of the target modified by its effects and handles the death
+func (w *World) dealDamage(source, target *types.GameObject, amount int32, isCrit bool) {
+	amount = max(int32(math.Round(float64(amount)*target.GetDamageTakenModifier())), 1)
+	target.TakeDamage(amount)
+
+	if source.Type == types.ObjectTypePlayer && target.Type == types.ObjectTypePlayer {
+		w.flagPvPCombat(source)
+	}
+
+	// NPCs hit from outside of the agro radius fight back as well
+	if target.Type == types.ObjectTypeNPC && target.Entity.CanAgro && !target.IsDead() {
+		target.GetThreat().Add(source.UUID, float64(amount)*DAMAGE_THREAT_FACTOR)
+	}
+
+	if !target.IsDead() {
+		DamageChannel <- &types.Damage{Object: target, Amount: amount, IsCrit: isCrit, HealthCurrent: int32(target.Entity.Health), HealthMax: int32(target.Entity.MaxHealth)}
+		return
+	}
+
+	if target.Type == types.ObjectTypePlayer {
+		DamageChannel <- &types.Damage{Object: target, Amount: amount, IsCrit: isCrit, HealthCurrent: int32(target.Entity.Health), HealthMax: int32(target.Entity.MaxHealth)}
+
+		source.AttackTargetUUID = ""
+		source.TargetPosition = nil
+		w.npcResetCurrentAnimation(source)
+
+		w.playerDied(source, target)
+		return
+	}
+
+	w.npcIsDead(source, target)
+}
+
+func (w *World) npcIsDead(killer, npc *types.GameObject) {
+	// loot
+	for i, item := range npcLoot {
+		w.dropItemOnGround(entity.GetItem(item), types.Vector3{X: npc.Position.X + LOOT_RADIUS*2*float64(i), Y: killer.Position.Y, Z: npc.Position.Z})
+	}
+	w.hideObject(npc.UUID)
+
+	DestroyObjectChannel <- &types.DestroyObject{Object: npc}
+}
+
+// canDamage applies the damage rules: NPCs don't hurt each other, nobody fights in safe zones but guards,
+// players fight each other by the PvP rules of the level
+func (w *World) canDamage(source, target *types.GameObject) bool {
+	if source.UUID == target.UUID || target.IsDead() {
+		return false
+	}
+
+	if target.Type != types.ObjectTypePlayer && target.Type != types.ObjectTypeNPC {
+		return false
+	}
+
+	// Evading NPCs are immune until they are back home
+	if target.IsReturningInProgress {
+		return false
+	}
+
+	if source.Type == types.ObjectTypeNPC && target.Type == types.ObjectTypeNPC {
+		return false
+	}
+
+	if w.isInSafeZone(source.Position) || w.isInSafeZone(target.Position) {
+		return source.Type == types.ObjectTypeNPC && w.isGuardTarget(source, target)
+	}
+
+	if source.Type == types.ObjectTypePlayer && target.Type == types.ObjectTypePlayer {
+		return w.canPvP(source, target)
+	}
+
+	return true
+}
+
+// getAttackTargets resolves who the hit lands on with the damage scale:
+// the target, everyone in the melee swing arc, or everyone in the explosion around the target
+func (w *World) getAttackTargets(source, target *types.GameObject) map[*types.GameObject]float64 {
+	targets := map[*types.GameObject]float64{}
+	if w.canDamage(source, target) {
+		targets[target] = 1
+	}
+
+	weapon := source.GetAttackWeapon()
+	radius := float64(weapon.AttackRadius)
+
+	switch {
+	case weapon.IsExplosive:
+		w.addSplashTargets(targets, source, target.Position, radius, target.UUID)
+	case !source.IsRangedAttack() && weapon.AttackArc > 0:
+		reach := math.Max(radius, float64(weapon.AttackRange))
+		for _, obj := range w.getObjectsInRadius(source.Position, reach) {
+			if _, ok := targets[obj]; ok || !w.canDamage(source, obj) {
+				continue
+			}
+
+			if isInAttackArc(source, obj.Position, float64(weapon.AttackArc)) {
+				targets[obj] = 1
+			}
+		}
+	}
+
+	return targets
+}
+
+// addSplashTargets adds everyone in the explosion radius who is not behind an obstacle
+func (w *World) addSplashTargets(targets map[*types.GameObject]float64, source *types.GameObject, center types.Vector3, radius float64, ignoreUUIDs ...string) {
+	for _, obj := range w.getObjectsInRadius(center, radius) {
+		if _, ok := targets[obj]; ok || !w.canDamage(source, obj) {
+			continue
+		}
+
+		if !w.hasLineOfSight(eyePosition(center), eyePosition(obj.Position), append(ignoreUUIDs, obj.UUID)...) {
+			continue
+		}
+
+		// Linear falloff to half damage at the edge
+		targets[obj] = 1 - 0.5*distance(center, obj.Position)/radius
+	}
+}
+
+func (w *World) getObjectsInRadius(center types.Vector3, radius float64) []*types.GameObject {
+	box := types.Box{
+		Min: types.Vector3f{center.X - radius, center.Y - radius, center.Z - radius},
+		Max: types.Vector3f{center.X + radius, center.Y + radius, center.Z + radius},
+	}
+
+	objects := make([]*types.GameObject, 0)
+	for _, obj := range w.elementsIn(box) {
+		if distance(center, obj.Position) <= radius {
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects
+}
+
+// isInAttackArc checks the angle between the attacker facing (rotation Y, degrees) and the position
+func isInAttackArc(source *types.GameObject, position types.Vector3, arc float64) bool {
+	if arc >= 360 {
+		return true
+	}
+
+	angle := math.Atan2(position.X-source.Position.X, position.Z-source.Position.Z) * (180.0 / math.Pi)
+	diff := math.Mod(math.Abs(angle-source.Rotation.Y), 360)
+	if diff > 180 {
+		diff = 360 - diff
+	}
+
+	return diff <= arc/2
+}
+
+func eyePosition(position types.Vector3) types.Vector3 {
+	position.Y += EYE_HEIGHT
+	return position
+}
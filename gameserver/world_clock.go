@@ -0,0 +1,108 @@
+package gameserver
+
+import (
+	"math"
+	"server/config"
+	"server/entity"
+	"server/events"
+	"server/proto/actionpb"
+	"server/types"
+	"time"
+)
+
+// WorldClock is the in-game time shared by all worlds
+type WorldClock struct {
+	startedAt time.Time
+	dayLength time.Duration
+	startHour float64
+}
+
+var Clock = NewWorldClock(config.DayLength, config.DayStartHour)
+
+func NewWorldClock(dayLength time.Duration, startHour float64) *WorldClock {
+	return &WorldClock{
+		startedAt: time.Now(),
+		dayLength: dayLength,
+		startHour: startHour,
+	}
+}
+
+// Hour returns in-game hour from 0 to 24
+func (c *WorldClock) Hour() float64 {
+	elapsed := time.Since(c.startedAt).Seconds() / c.dayLength.Seconds() * 24
+
+	return math.Mod(c.startHour+elapsed, 24)
+}
+
+func (c *WorldClock) TimeOfDay() entity.TimeOfDay {
+	hour := c.Hour()
+	if hour >= config.DayStartHour && hour < config.NightStartHour {
+		return entity.TimeDay
+	}
+
+	return entity.TimeNight
+}
+
+// getAgroRadius returns the NPC agro radius for the current time of day and weather
+func (w *World) getAgroRadius(object *types.GameObject) float64 {
+	radius := AGRO_RADIUS
+	if object.Entity.AgroRadius > 0 {
+		radius = float64(object.Entity.AgroRadius)
+	}
+
+	behaviour := object.Entity.GetBehaviour(Clock.TimeOfDay())
+	if behaviour.AgroRadius > 0 {
+		radius = float64(behaviour.AgroRadius)
+	}
+
+	if modifier, ok := weatherAgroModifiers[w.getWeatherAt(object.Position)]; ok {
+		radius *= modifier
+	}
+
+	return radius
+}
+
+// npcTimeOfDayTick despawns NPCs hidden at the current time of day, they come back through the respawn tick
+func (w *World) npcTimeOfDayTick(objects []*types.GameObject) {
+	timeOfDay := Clock.TimeOfDay()
+
+	for _, object := range objects {
+		if object.Type != types.ObjectTypeNPC || object.IsDead() || object.AttackTargetUUID != "" {
+			continue
+		}
+
+		if !object.Entity.GetBehaviour(timeOfDay).IsHidden {
+			continue
+		}
+
+		now := time.Now()
+		object.Entity.Health = 0
+		object.NextSpawnTime = &now
+		object.Path = nil
+
+		w.hideObject(object.UUID)
+		DestroyObjectChannel <- &types.DestroyObject{Object: object}
+	}
+}
+
+func (c *WorldClock) getPayload() *actionpb.Action {
+	return events.GetWorldTimePayload(float32(c.Hour()), float32(c.dayLength.Seconds()), c.TimeOfDay())
+}
+
+func ProcessWorldTimeBroadcast() {
+	ticker := time.NewTicker(config.WorldTimeBroadcastInterval)
+	for range ticker.C {
+		msg := Clock.getPayload()
+
+		TCPState.RLock()
+		uuids := make([]string, 0, len(TCPState.clients))
+		for uuid := range TCPState.clients {
+			uuids = append(uuids, uuid)
+		}
+		TCPState.RUnlock()
+
+		for _, uuid := range uuids {
+			TCPState.sendToClient(uuid, msg)
+		}
+	}
+}
@@ -8,7 +8,9 @@ import (
 func (w *World) onWalkUpdates(object *types.GameObject) {
 	prevNeighbors := object.Neighbors
 
+	object.RecordPosition()
 	w.moveObjectTo(object)
+	w.onGroundStep(object)
 
 	newNeighbors := object.Neighbors
 	added, removed := findChanges(prevNeighbors, newNeighbors)
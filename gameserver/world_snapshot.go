@@ -0,0 +1,177 @@
+package gameserver
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"server/config"
+	"server/entity"
+	"server/types"
+	"time"
+)
+
+// WorldSnapshot is the dynamic state of a level, static objects come from the level file
+type WorldSnapshot struct {
+	SavedAt time.Time
+	Objects map[string]ObjectSnapshot // By object UUID
+	Loot    []LootSnapshot
+}
+
+type ObjectSnapshot struct {
+	Health         int32
+	VariationIndex int32
+	NextVariation  *types.NextVariation
+	NextSpawnTime  *time.Time
+}
+
+type LootSnapshot struct {
+	UUID        string
+	Entity      entity.Entity
+	Position    types.Vector3
+	DestroyTime *time.Time
+}
+
+func getSnapshotPath(worldName string) string {
+	return filepath.Join(config.SnapshotDir, worldName+".gob")
+}
+
+func (w *World) getSnapshot() *WorldSnapshot {
+	w.RLock()
+	defer w.RUnlock()
+
+	snapshot := &WorldSnapshot{
+		SavedAt: time.Now(),
+		Objects: map[string]ObjectSnapshot{},
+	}
+
+	for _, obj := range w.objects {
+		switch obj.Type {
+		case types.ObjectTypeNPC, types.ObjectTypeVariantMapObject:
+			snapshot.Objects[obj.UUID] = ObjectSnapshot{
+				Health:         obj.Entity.Health,
+				VariationIndex: obj.VariationIndex,
+				NextVariation:  obj.NextVariation,
+				NextSpawnTime:  obj.NextSpawnTime,
+			}
+		case types.ObjectTypeMapObject:
+			snapshot.Loot = append(snapshot.Loot, LootSnapshot{
+				UUID:        obj.UUID,
+				Entity:      obj.Entity,
+				Position:    obj.Position,
+				DestroyTime: obj.DestroyTime,
+			})
+		}
+	}
+
+	return snapshot
+}
+
+// saveSnapshot writes to a temporary file and renames it, so a crash never leaves a half written snapshot
+func (w *World) saveSnapshot() error {
+	snapshot := w.getSnapshot()
+
+	if err := os.MkdirAll(config.SnapshotDir, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(config.SnapshotDir, w.Name+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := gob.NewEncoder(file).Encode(snapshot); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), getSnapshotPath(w.Name))
+}
+
+// restoreSnapshot applies the saved state on top of the objects created from the level
+func (w *World) restoreSnapshot() error {
+	file, err := os.Open(getSnapshotPath(w.Name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	snapshot := &WorldSnapshot{}
+	if err := gob.NewDecoder(file).Decode(snapshot); err != nil {
+		return err
+	}
+
+	restored := 0
+	for uuid, state := range snapshot.Objects {
+		obj, err := w.getObject(uuid)
+		if err != nil {
+			// The level has changed since the snapshot
+			continue
+		}
+
+		obj.Entity.Health = state.Health
+		obj.VariationIndex = state.VariationIndex
+		obj.NextVariation = state.NextVariation
+		obj.NextSpawnTime = state.NextSpawnTime
+
+		if obj.Type == types.ObjectTypeNPC && obj.NextSpawnTime != nil {
+			w.hideObject(obj.UUID)
+		}
+
+		restored++
+	}
+
+	for _, loot := range snapshot.Loot {
+		if loot.DestroyTime != nil && time.Now().After(*loot.DestroyTime) {
+			continue
+		}
+
+		w.addObject(&types.GameObject{
+			Entity:      loot.Entity,
+			Position:    loot.Position,
+			UUID:        loot.UUID,
+			Type:        types.ObjectTypeMapObject,
+			DestroyTime: loot.DestroyTime,
+		})
+		restored++
+	}
+
+	fmt.Printf("Snapshot of %s from %s restored, objects: %d\n", w.Name, snapshot.SavedAt.Format(time.RFC3339), restored)
+
+	return nil
+}
+
+// ProcessWorldSnapshots periodically saves persistent levels, instances are thrown away on teardown
+func ProcessWorldSnapshots() {
+	ticker := time.NewTicker(config.SnapshotInterval)
+	for range ticker.C {
+		for _, levelConfig := range config.Levels {
+			if levelConfig.IsInstance {
+				continue
+			}
+
+			world := Worlds.get(levelConfig.Name)
+			if world == nil {
+				continue
+			}
+
+			if err := world.saveSnapshot(); err != nil {
+				fmt.Printf("Error saving snapshot of %s: %v\n", world.Name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package gameserver
+
+import (
+	"fmt"
+	"math"
+	"server/events"
+	"server/types"
+	"strings"
+)
+
+const TELEPORT_TRIGGER_RADIUS float64 = 1.5
+
+// checkTeleportTrigger moves the object to the teleport target when it walks into a teleport
+func (w *World) checkTeleportTrigger(obj *types.GameObject) {
+	var entered *LevelTeleport
+
+	for _, teleport := range w.teleports {
+		isInside := distance2D(obj.Position, teleport.Position) <= TELEPORT_TRIGGER_RADIUS
+
+		if obj.ArrivalTeleport == teleport.Name {
+			if !isInside {
+				obj.ArrivalTeleport = ""
+			}
+			continue
+		}
+
+		if isInside && teleport.Target != "" && entered == nil {
+			entered = teleport
+		}
+	}
+
+	if entered == nil {
+		return
+	}
+
+	if strings.HasPrefix(entered.Target, instanceTargetPrefix) {
+		Instances.handleTeleport(w, obj, entered)
+		return
+	}
+
+	targetWorld, target := w.resolveTeleportTarget(entered.Target)
+	if target == nil {
+		fmt.Println("Teleport target not found: ", entered.Target)
+		return
+	}
+
+	w.teleportObject(obj, targetWorld, target)
+}
+
+// resolveTeleportTarget supports "name" for teleports of the same level and "level:name" for other levels
+func (w *World) resolveTeleportTarget(target string) (*World, *LevelTeleport) {
+	world := w
+	name := target
+
+	if level, teleportName, found := strings.Cut(target, ":"); found {
+		world = Worlds.get(level)
+		name = teleportName
+	}
+
+	if world == nil {
+		return nil, nil
+	}
+
+	return world, world.getTeleport(name)
+}
+
+func (w *World) teleportObject(obj *types.GameObject, target *World, teleport *LevelTeleport) {
+	position := target.getArrivalPosition(teleport)
+	rotation := types.Vector3{X: 0, Y: teleport.Rotation.Y, Z: 0}
+	obj.ArrivalTeleport = teleport.Name
+
+	if target != w {
+		w.transferObject(obj, target, position, rotation)
+		return
+	}
+
+	obj.Position = position
+	obj.Rotation = rotation
+	w.onWalkUpdates(obj)
+
+	TeleportObjectChannel <- &types.TeleportObject{Object: obj, Position: position, Rotation: rotation}
+}
+
+// transferObject moves the object into another world instance and resends the surroundings to the player
+func (w *World) transferObject(obj *types.GameObject, target *World, position, rotation types.Vector3) {
+	prevNeighbors := obj.Neighbors
+	w.removeObject(obj.UUID)
+
+	for _, neighbor := range prevNeighbors {
+		if neighbor.Type != types.ObjectTypePlayer && neighbor.Type != types.ObjectTypeNPC && neighbor.Type != types.ObjectTypeMapObject {
+			continue
+		}
+
+		if neighbor.Type == types.ObjectTypePlayer {
+			TCPState.sendToClient(neighbor.UUID, events.GetDestroyObjectEventPayload(obj.UUID))
+		}
+
+		if obj.Type == types.ObjectTypePlayer {
+			TCPState.sendToClient(obj.UUID, events.GetDestroyObjectEventPayload(neighbor.UUID))
+		}
+	}
+
+	fmt.Printf("Object %s moved from %s to %s\n", obj.UUID, w.Name, target.Name)
+
+	obj.Position = position
+	obj.Rotation = rotation
+	obj.Path = nil
+
+	target.addObject(obj)
+	target.updateNeighbors(obj)
+	target.updateNeighborsNearObject(obj)
+
+	if obj.Type != types.ObjectTypePlayer {
+		SpawnObjectChannel <- &types.SpawnObject{Object: obj}
+		return
+	}
+
+	TCPState.sendToClient(obj.UUID, events.GetTeleportEventPayload(obj.UUID, position, rotation, target.Level))
+	TCPState.sendWorldState(obj)
+	TCPState.sendToClient(obj.UUID, target.zoneAt(position.X, position.Z).getWeatherPayload())
+}
+
+func distance2D(p1, p2 types.Vector3) float64 {
+	return math.Sqrt(math.Pow(p1.X-p2.X, 2) + math.Pow(p1.Z-p2.Z, 2))
+}
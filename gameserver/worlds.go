@@ -0,0 +1,66 @@
+package gameserver
+
+import (
+	"errors"
+	"server/types"
+	"sync"
+)
+
+// WorldsState keeps all world instances hosted by the server
+type WorldsState struct {
+	sync.RWMutex
+	worlds map[string]*World
+}
+
+var Worlds = &WorldsState{worlds: map[string]*World{}}
+
+func (s *WorldsState) add(world *World) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.worlds[world.Name] = world
+}
+
+func (s *WorldsState) remove(name string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.worlds, name)
+}
+
+func (s *WorldsState) get(name string) *World {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.worlds[name]
+}
+
+func (s *WorldsState) getAll() []*World {
+	s.RLock()
+	defer s.RUnlock()
+
+	worlds := make([]*World, 0, len(s.worlds))
+	for _, world := range s.worlds {
+		worlds = append(worlds, world)
+	}
+
+	return worlds
+}
+
+func (s *WorldsState) getObjectWorld(obj *types.GameObject) *World {
+	return s.get(obj.WorldName)
+}
+
+// findObject looks up the object in every world, used for clients that can be in any level
+func (s *WorldsState) findObject(uuid string) (*World, *types.GameObject, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	for _, world := range s.worlds {
+		if obj, err := world.getObject(uuid); err == nil {
+			return world, obj, nil
+		}
+	}
+
+	return nil, nil, errors.New("object not found")
+}
@@ -0,0 +1,147 @@
+package gameserver
+
+import (
+	"fmt"
+	"math"
+	"server/config"
+	"server/types"
+	"sync"
+	"time"
+)
+
+// Zone is a rectangular part of the world with its own spatial index and simulation loop
+type Zone struct {
+	sync.RWMutex
+	Name    string
+	Rect    config.ZoneRect
+	Octree  *types.Octree
+	objects map[string]*types.GameObject
+	world   *World
+	weather *Weather
+
+	projectiles []*Projectile // Projectiles fired in the zone, they keep flying across the borders
+
+	contentUpdates chan *contentUpdate // Reloaded definitions applied to the live objects on the next tick
+}
+
+func newZone(world *World, rect config.ZoneRect, size float64) *Zone {
+	oct := types.CreateOctree(
+		types.Vector3f{-size, -size, -size},
+		types.Vector3f{size, size, size},
+	)
+
+	return &Zone{
+		Name:    rect.Name,
+		Rect:    rect,
+		Octree:  oct,
+		objects: make(map[string]*types.GameObject),
+		world:   world,
+		weather: newWeather(),
+
+		contentUpdates: make(chan *contentUpdate, 1),
+	}
+}
+
+func (z *Zone) contains(x, zPos float64) bool {
+	return x >= z.Rect.MinX && x < z.Rect.MaxX && zPos >= z.Rect.MinZ && zPos < z.Rect.MaxZ
+}
+
+// distanceTo returns the distance on X/Z plane from the point to the zone rectangle
+func (z *Zone) distanceTo(x, zPos float64) float64 {
+	dx := math.Max(math.Max(z.Rect.MinX-x, 0), x-z.Rect.MaxX)
+	dz := math.Max(math.Max(z.Rect.MinZ-zPos, 0), zPos-z.Rect.MaxZ)
+	return math.Sqrt(dx*dx + dz*dz)
+}
+
+func (z *Zone) intersects(box types.Box) bool {
+	return box.Min[0] < z.Rect.MaxX && box.Max[0] >= z.Rect.MinX && box.Min[2] < z.Rect.MaxZ && box.Max[2] >= z.Rect.MinZ
+}
+
+func (z *Zone) add(obj *types.GameObject) {
+	z.Lock()
+	defer z.Unlock()
+
+	obj.Node = z.Octree.Add(obj, types.Vector3f{obj.Position.X, obj.Position.Y, obj.Position.Z})
+	z.objects[obj.UUID] = obj
+}
+
+// hide removes the object from the spatial index but keeps it simulated (dead NPCs waiting for respawn)
+func (z *Zone) hide(obj *types.GameObject) {
+	z.Lock()
+	defer z.Unlock()
+
+	z.Octree.RemoveUsing(*obj, obj.Node)
+}
+
+func (z *Zone) remove(obj *types.GameObject) {
+	z.Lock()
+	defer z.Unlock()
+
+	z.Octree.RemoveUsing(*obj, obj.Node)
+	delete(z.objects, obj.UUID)
+}
+
+func (z *Zone) move(obj *types.GameObject) {
+	z.Lock()
+	defer z.Unlock()
+
+	z.Octree.RemoveUsing(*obj, obj.Node)
+	obj.Node = z.Octree.Add(obj, types.Vector3f{obj.Position.X, obj.Position.Y, obj.Position.Z})
+}
+
+func (z *Zone) elementsIn(box types.Box) []*types.GameObject {
+	z.RLock()
+	defer z.RUnlock()
+
+	return z.Octree.ElementsIn(box)
+}
+
+func (z *Zone) elementsAt(point types.Vector3f) []*types.GameObject {
+	z.RLock()
+	defer z.RUnlock()
+
+	return z.Octree.ElementsAt(point)
+}
+
+func (z *Zone) getObjects() []*types.GameObject {
+	z.RLock()
+	defer z.RUnlock()
+
+	objects := make([]*types.GameObject, 0, len(z.objects))
+	for _, obj := range z.objects {
+		objects = append(objects, obj)
+	}
+
+	return objects
+}
+
+func (z *Zone) run() {
+	fmt.Println("Starting zone", z.Name)
+
+	// Run zone ticker 25 times per second
+	ticker := time.NewTicker(40 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-z.world.stop:
+			fmt.Println("Stopping zone", z.world.Name, z.Name)
+			return
+		case <-ticker.C:
+		}
+
+		z.contentTick()
+		z.weatherTick()
+		z.projectileTick()
+
+		objects := z.getObjects()
+
+		z.world.statusEffectTick(objects)
+		z.world.npcTimeOfDayTick(objects)
+		z.world.npcRespawnTick(objects)
+		z.world.npcWalkTick(objects)
+		z.world.npcAttackTick(objects)
+		z.world.mapObjectVariationTick(objects)
+		z.world.mapObjectDestroyTick(objects)
+	}
+}
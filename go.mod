@@ -4,6 +4,7 @@ go 1.21.5
 
 require (
 	github.com/google/uuid v1.6.0
+	golang.org/x/crypto v0.22.0
 	google.golang.org/protobuf v1.33.0
 )
 
@@ -30,7 +31,6 @@ require (
 	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
 	github.com/ugorji/go/codec v1.2.12 // indirect
 	golang.org/x/arch v0.7.0 // indirect
-	golang.org/x/crypto v0.22.0 // indirect
 	golang.org/x/net v0.24.0 // indirect
 	golang.org/x/sys v0.19.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
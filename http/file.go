@@ -6,8 +6,13 @@ import (
 	"server/config"
 )
 
-func getWorldFile() (*os.File, error) {
-	file, err := os.Open(config.WorldFilePath)
+func getWorldFile(name string) (*os.File, error) {
+	level := config.GetLevel(name)
+	if level == nil {
+		return nil, errors.New("level not found")
+	}
+
+	file, err := os.Open(level.FilePath)
 	if err != nil {
 		return nil, errors.New("error opening file")
 	}
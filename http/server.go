@@ -1,9 +1,11 @@
 package http
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"server/config"
+	"server/gameserver"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,6 +18,15 @@ func init() {
 	// gin.SetMode(gin.ReleaseMode)
 }
 
+// isAdmin checks the admin token in constant time, the admin endpoints are disabled without a token
+func isAdmin(c *gin.Context) bool {
+	if config.AdminToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(c.GetHeader("X-Admin-Token")), []byte(config.AdminToken)) == 1
+}
+
 func Start() {
 	r := gin.Default()
 	fmt.Println("Starting http server on port: ", httpPort)
@@ -27,7 +38,8 @@ func Start() {
 	})
 
 	r.GET("/world-stat", func(c *gin.Context) {
-		file, err := getWorldFile()
+		name := c.DefaultQuery("level", config.WorldName)
+		file, err := getWorldFile(name)
 
 		if err != nil {
 			c.JSON(500, gin.H{
@@ -49,13 +61,77 @@ func Start() {
 
 		c.JSON(200, gin.H{
 			"size":  fileSize,
-			"world": config.WorldName,
+			"world": name,
 		})
 	})
 
 	r.GET("/download-world", func(c *gin.Context) {
-		c.Header("World", "island")
-		c.File(config.WorldFilePath)
+		name := c.DefaultQuery("level", config.WorldName)
+		level := config.GetLevel(name)
+		if level == nil {
+			c.JSON(404, gin.H{
+				"error": "level not found",
+			})
+			return
+		}
+
+		c.Header("World", level.Name)
+		c.File(level.FilePath)
+	})
+
+	// Reloads entity and item definitions, ?live=true applies them to spawned objects as well
+	r.POST("/admin/reload-content", func(c *gin.Context) {
+		if !isAdmin(c) {
+			c.JSON(403, gin.H{
+				"error": "forbidden",
+			})
+			return
+		}
+
+		diff, updated, err := gameserver.ReloadContent(c.Query("live") == "true")
+		if err != nil {
+			c.JSON(422, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(200, gin.H{
+			"diff":         diff,
+			"live_updated": updated,
+		})
+	})
+
+	// Sets the password of an account, legacy accounts can't log in until it is set unless they are claimed
+	r.POST("/admin/account-password", func(c *gin.Context) {
+		if !isAdmin(c) {
+			c.JSON(403, gin.H{
+				"error": "forbidden",
+			})
+			return
+		}
+
+		var request struct {
+			Account  string `json:"account"`
+			Password string `json:"password"`
+		}
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(400, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		if err := gameserver.SetAccountPassword(request.Account, request.Password); err != nil {
+			c.JSON(422, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+
+		c.JSON(200, gin.H{
+			"account": request.Account,
+		})
 	})
 
 	r.GET("/Assets/*filepath", func(ctx *gin.Context) {
@@ -1,8 +1,13 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"server/config"
+	"server/entity"
 	"server/gameserver"
 	"server/http"
+	"server/utils"
 )
 
 const (
@@ -12,6 +17,16 @@ const (
 func main() {
 	ch := make(chan int)
 
+	if err := entity.LoadContent(config.ContentDir); err != nil {
+		fmt.Printf("Invalid content in %s:\n%v\n", config.ContentDir, err)
+		os.Exit(1)
+	}
+
+	if err := utils.LoadGrid(utils.FILE_PATH); err != nil {
+		fmt.Println("Error int grid file reading: ", err)
+		os.Exit(1)
+	}
+
 	go gameserver.StartGameServer()
 	go http.Start()
 
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.3
+// source: proto/accountpb/account.proto
+
+package accountpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	objectpb "server/proto/objectpb"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Unknown accounts are registered with the password on the first login
+type Login struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *Login) Reset() {
+	*x = Login{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Login) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Login) ProtoMessage() {}
+
+func (x *Login) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Login.ProtoReflect.Descriptor instead.
+func (*Login) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Login) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Login) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type Character struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HumanCharacter *objectpb.HumanCharacter `protobuf:"bytes,2,opt,name=human_character,json=humanCharacter,proto3" json:"human_character,omitempty"`
+	Level          string                   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"` // Level the character logged out in
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Character) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetHumanCharacter() *objectpb.HumanCharacter {
+	if x != nil {
+		return x.HumanCharacter
+	}
+	return nil
+}
+
+func (x *Character) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// Sent after login and every change, the client shows the character select
+type CharacterList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Characters    []*Character `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	MaxCharacters int32        `protobuf:"varint,2,opt,name=max_characters,json=maxCharacters,proto3" json:"max_characters,omitempty"`
+}
+
+func (x *CharacterList) Reset() {
+	*x = CharacterList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharacterList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterList) ProtoMessage() {}
+
+func (x *CharacterList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterList.ProtoReflect.Descriptor instead.
+func (*CharacterList) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CharacterList) GetCharacters() []*Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *CharacterList) GetMaxCharacters() int32 {
+	if x != nil {
+		return x.MaxCharacters
+	}
+	return 0
+}
+
+type CreateCharacter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HumanCharacter *objectpb.HumanCharacter `protobuf:"bytes,2,opt,name=human_character,json=humanCharacter,proto3" json:"human_character,omitempty"`
+}
+
+func (x *CreateCharacter) Reset() {
+	*x = CreateCharacter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCharacter) ProtoMessage() {}
+
+func (x *CreateCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCharacter.ProtoReflect.Descriptor instead.
+func (*CreateCharacter) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCharacter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCharacter) GetHumanCharacter() *objectpb.HumanCharacter {
+	if x != nil {
+		return x.HumanCharacter
+	}
+	return nil
+}
+
+type SelectCharacter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SelectCharacter) Reset() {
+	*x = SelectCharacter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelectCharacter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCharacter) ProtoMessage() {}
+
+func (x *SelectCharacter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCharacter.ProtoReflect.Descriptor instead.
+func (*SelectCharacter) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SelectCharacter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AppearanceSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot       string   `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Recipes    []string `protobuf:"bytes,2,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	IsRequired bool     `protobuf:"varint,3,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+}
+
+func (x *AppearanceSlot) Reset() {
+	*x = AppearanceSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppearanceSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppearanceSlot) ProtoMessage() {}
+
+func (x *AppearanceSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppearanceSlot.ProtoReflect.Descriptor instead.
+func (*AppearanceSlot) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppearanceSlot) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *AppearanceSlot) GetRecipes() []string {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *AppearanceSlot) GetIsRequired() bool {
+	if x != nil {
+		return x.IsRequired
+	}
+	return false
+}
+
+type AppearanceGender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gender string            `protobuf:"bytes,1,opt,name=gender,proto3" json:"gender,omitempty"`
+	Slots  []*AppearanceSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *AppearanceGender) Reset() {
+	*x = AppearanceGender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppearanceGender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppearanceGender) ProtoMessage() {}
+
+func (x *AppearanceGender) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppearanceGender.ProtoReflect.Descriptor instead.
+func (*AppearanceGender) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AppearanceGender) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *AppearanceGender) GetSlots() []*AppearanceSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Recipes and colours accepted by CreateCharacter
+type AppearanceCatalogue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genders []*AppearanceGender `protobuf:"bytes,1,rep,name=genders,proto3" json:"genders,omitempty"`
+	Colors  []string            `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`
+}
+
+func (x *AppearanceCatalogue) Reset() {
+	*x = AppearanceCatalogue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_accountpb_account_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppearanceCatalogue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppearanceCatalogue) ProtoMessage() {}
+
+func (x *AppearanceCatalogue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_accountpb_account_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppearanceCatalogue.ProtoReflect.Descriptor instead.
+func (*AppearanceCatalogue) Descriptor() ([]byte, []int) {
+	return file_proto_accountpb_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AppearanceCatalogue) GetGenders() []*AppearanceGender {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *AppearanceCatalogue) GetColors() []string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+var File_proto_accountpb_account_proto protoreflect.FileDescriptor
+
+var file_proto_accountpb_account_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x70,
+	0x62, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x70, 0x62, 0x2f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x78, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x75, 0x6d, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x68, 0x75, 0x6d, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
+	0x6b, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x22, 0x68, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x75, 0x6d, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a,
+	0x0e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x5a,
+	0x0a, 0x10, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x42,
+	0x18, 0x5a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_accountpb_account_proto_rawDescOnce sync.Once
+	file_proto_accountpb_account_proto_rawDescData = file_proto_accountpb_account_proto_rawDesc
+)
+
+func file_proto_accountpb_account_proto_rawDescGZIP() []byte {
+	file_proto_accountpb_account_proto_rawDescOnce.Do(func() {
+		file_proto_accountpb_account_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_accountpb_account_proto_rawDescData)
+	})
+	return file_proto_accountpb_account_proto_rawDescData
+}
+
+var file_proto_accountpb_account_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_accountpb_account_proto_goTypes = []interface{}{
+	(*Login)(nil),                   // 0: messages.Login
+	(*Character)(nil),               // 1: messages.Character
+	(*CharacterList)(nil),           // 2: messages.CharacterList
+	(*CreateCharacter)(nil),         // 3: messages.CreateCharacter
+	(*SelectCharacter)(nil),         // 4: messages.SelectCharacter
+	(*AppearanceSlot)(nil),          // 5: messages.AppearanceSlot
+	(*AppearanceGender)(nil),        // 6: messages.AppearanceGender
+	(*AppearanceCatalogue)(nil),     // 7: messages.AppearanceCatalogue
+	(*objectpb.HumanCharacter)(nil), // 8: messages.HumanCharacter
+}
+var file_proto_accountpb_account_proto_depIdxs = []int32{
+	8, // 0: messages.Character.human_character:type_name -> messages.HumanCharacter
+	1, // 1: messages.CharacterList.characters:type_name -> messages.Character
+	8, // 2: messages.CreateCharacter.human_character:type_name -> messages.HumanCharacter
+	5, // 3: messages.AppearanceGender.slots:type_name -> messages.AppearanceSlot
+	6, // 4: messages.AppearanceCatalogue.genders:type_name -> messages.AppearanceGender
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_accountpb_account_proto_init() }
+func file_proto_accountpb_account_proto_init() {
+	if File_proto_accountpb_account_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_accountpb_account_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Login); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacterList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCharacter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectCharacter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppearanceSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppearanceGender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_accountpb_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppearanceCatalogue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_accountpb_account_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_accountpb_account_proto_goTypes,
+		DependencyIndexes: file_proto_accountpb_account_proto_depIdxs,
+		MessageInfos:      file_proto_accountpb_account_proto_msgTypes,
+	}.Build()
+	File_proto_accountpb_account_proto = out.File
+	file_proto_accountpb_account_proto_rawDesc = nil
+	file_proto_accountpb_account_proto_goTypes = nil
+	file_proto_accountpb_account_proto_depIdxs = nil
+}
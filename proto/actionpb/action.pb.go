@@ -10,13 +10,16 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	accountpb "server/proto/accountpb"
 	animationpb "server/proto/animationpb"
+	combatpb "server/proto/combatpb"
 	interactpb "server/proto/interactpb"
 	messagepb "server/proto/messagepb"
 	objectpb "server/proto/objectpb"
 	pingpb "server/proto/pingpb"
 	soundpb "server/proto/soundpb"
 	transformpb "server/proto/transformpb"
+	worldpb "server/proto/worldpb"
 	sync "sync"
 )
 
@@ -51,6 +54,21 @@ type Action struct {
 	//	*Action_InteractWith
 	//	*Action_InteractQueue
 	//	*Action_Teleport
+	//	*Action_WorldTime
+	//	*Action_Weather
+	//	*Action_Login
+	//	*Action_CharacterList
+	//	*Action_CreateCharacter
+	//	*Action_SelectCharacter
+	//	*Action_AppearanceCatalogue
+	//	*Action_ProjectileSpawn
+	//	*Action_ProjectileImpact
+	//	*Action_Death
+	//	*Action_Respawn
+	//	*Action_StatusEffect
+	//	*Action_StatusEffectRemove
+	//	*Action_PvpFlag
+	//	*Action_PvpStatus
 	Action isAction_Action `protobuf_oneof:"action"`
 }
 
@@ -212,6 +230,111 @@ func (x *Action) GetTeleport() *transformpb.Teleport {
 	return nil
 }
 
+func (x *Action) GetWorldTime() *worldpb.WorldTime {
+	if x, ok := x.GetAction().(*Action_WorldTime); ok {
+		return x.WorldTime
+	}
+	return nil
+}
+
+func (x *Action) GetWeather() *worldpb.Weather {
+	if x, ok := x.GetAction().(*Action_Weather); ok {
+		return x.Weather
+	}
+	return nil
+}
+
+func (x *Action) GetLogin() *accountpb.Login {
+	if x, ok := x.GetAction().(*Action_Login); ok {
+		return x.Login
+	}
+	return nil
+}
+
+func (x *Action) GetCharacterList() *accountpb.CharacterList {
+	if x, ok := x.GetAction().(*Action_CharacterList); ok {
+		return x.CharacterList
+	}
+	return nil
+}
+
+func (x *Action) GetCreateCharacter() *accountpb.CreateCharacter {
+	if x, ok := x.GetAction().(*Action_CreateCharacter); ok {
+		return x.CreateCharacter
+	}
+	return nil
+}
+
+func (x *Action) GetSelectCharacter() *accountpb.SelectCharacter {
+	if x, ok := x.GetAction().(*Action_SelectCharacter); ok {
+		return x.SelectCharacter
+	}
+	return nil
+}
+
+func (x *Action) GetAppearanceCatalogue() *accountpb.AppearanceCatalogue {
+	if x, ok := x.GetAction().(*Action_AppearanceCatalogue); ok {
+		return x.AppearanceCatalogue
+	}
+	return nil
+}
+
+func (x *Action) GetProjectileSpawn() *combatpb.ProjectileSpawn {
+	if x, ok := x.GetAction().(*Action_ProjectileSpawn); ok {
+		return x.ProjectileSpawn
+	}
+	return nil
+}
+
+func (x *Action) GetProjectileImpact() *combatpb.ProjectileImpact {
+	if x, ok := x.GetAction().(*Action_ProjectileImpact); ok {
+		return x.ProjectileImpact
+	}
+	return nil
+}
+
+func (x *Action) GetDeath() *combatpb.Death {
+	if x, ok := x.GetAction().(*Action_Death); ok {
+		return x.Death
+	}
+	return nil
+}
+
+func (x *Action) GetRespawn() *combatpb.Respawn {
+	if x, ok := x.GetAction().(*Action_Respawn); ok {
+		return x.Respawn
+	}
+	return nil
+}
+
+func (x *Action) GetStatusEffect() *objectpb.StatusEffect {
+	if x, ok := x.GetAction().(*Action_StatusEffect); ok {
+		return x.StatusEffect
+	}
+	return nil
+}
+
+func (x *Action) GetStatusEffectRemove() *objectpb.StatusEffectRemove {
+	if x, ok := x.GetAction().(*Action_StatusEffectRemove); ok {
+		return x.StatusEffectRemove
+	}
+	return nil
+}
+
+func (x *Action) GetPvpFlag() *combatpb.PvPFlag {
+	if x, ok := x.GetAction().(*Action_PvpFlag); ok {
+		return x.PvpFlag
+	}
+	return nil
+}
+
+func (x *Action) GetPvpStatus() *combatpb.PvPStatus {
+	if x, ok := x.GetAction().(*Action_PvpStatus); ok {
+		return x.PvpStatus
+	}
+	return nil
+}
+
 type isAction_Action interface {
 	isAction_Action()
 }
@@ -284,6 +407,66 @@ type Action_Teleport struct {
 	Teleport *transformpb.Teleport `protobuf:"bytes,17,opt,name=teleport,proto3,oneof"`
 }
 
+type Action_WorldTime struct {
+	WorldTime *worldpb.WorldTime `protobuf:"bytes,18,opt,name=worldTime,proto3,oneof"`
+}
+
+type Action_Weather struct {
+	Weather *worldpb.Weather `protobuf:"bytes,19,opt,name=weather,proto3,oneof"`
+}
+
+type Action_Login struct {
+	Login *accountpb.Login `protobuf:"bytes,20,opt,name=login,proto3,oneof"`
+}
+
+type Action_CharacterList struct {
+	CharacterList *accountpb.CharacterList `protobuf:"bytes,21,opt,name=characterList,proto3,oneof"`
+}
+
+type Action_CreateCharacter struct {
+	CreateCharacter *accountpb.CreateCharacter `protobuf:"bytes,22,opt,name=createCharacter,proto3,oneof"`
+}
+
+type Action_SelectCharacter struct {
+	SelectCharacter *accountpb.SelectCharacter `protobuf:"bytes,23,opt,name=selectCharacter,proto3,oneof"`
+}
+
+type Action_AppearanceCatalogue struct {
+	AppearanceCatalogue *accountpb.AppearanceCatalogue `protobuf:"bytes,24,opt,name=appearanceCatalogue,proto3,oneof"`
+}
+
+type Action_ProjectileSpawn struct {
+	ProjectileSpawn *combatpb.ProjectileSpawn `protobuf:"bytes,25,opt,name=projectileSpawn,proto3,oneof"`
+}
+
+type Action_ProjectileImpact struct {
+	ProjectileImpact *combatpb.ProjectileImpact `protobuf:"bytes,26,opt,name=projectileImpact,proto3,oneof"`
+}
+
+type Action_Death struct {
+	Death *combatpb.Death `protobuf:"bytes,27,opt,name=death,proto3,oneof"`
+}
+
+type Action_Respawn struct {
+	Respawn *combatpb.Respawn `protobuf:"bytes,28,opt,name=respawn,proto3,oneof"`
+}
+
+type Action_StatusEffect struct {
+	StatusEffect *objectpb.StatusEffect `protobuf:"bytes,29,opt,name=statusEffect,proto3,oneof"`
+}
+
+type Action_StatusEffectRemove struct {
+	StatusEffectRemove *objectpb.StatusEffectRemove `protobuf:"bytes,30,opt,name=statusEffectRemove,proto3,oneof"`
+}
+
+type Action_PvpFlag struct {
+	PvpFlag *combatpb.PvPFlag `protobuf:"bytes,31,opt,name=pvpFlag,proto3,oneof"`
+}
+
+type Action_PvpStatus struct {
+	PvpStatus *combatpb.PvPStatus `protobuf:"bytes,32,opt,name=pvpStatus,proto3,oneof"`
+}
+
 func (*Action_Transform) isAction_Action() {}
 
 func (*Action_TransformRotation) isAction_Action() {}
@@ -318,6 +501,36 @@ func (*Action_InteractQueue) isAction_Action() {}
 
 func (*Action_Teleport) isAction_Action() {}
 
+func (*Action_WorldTime) isAction_Action() {}
+
+func (*Action_Weather) isAction_Action() {}
+
+func (*Action_Login) isAction_Action() {}
+
+func (*Action_CharacterList) isAction_Action() {}
+
+func (*Action_CreateCharacter) isAction_Action() {}
+
+func (*Action_SelectCharacter) isAction_Action() {}
+
+func (*Action_AppearanceCatalogue) isAction_Action() {}
+
+func (*Action_ProjectileSpawn) isAction_Action() {}
+
+func (*Action_ProjectileImpact) isAction_Action() {}
+
+func (*Action_Death) isAction_Action() {}
+
+func (*Action_Respawn) isAction_Action() {}
+
+func (*Action_StatusEffect) isAction_Action() {}
+
+func (*Action_StatusEffectRemove) isAction_Action() {}
+
+func (*Action_PvpFlag) isAction_Action() {}
+
+func (*Action_PvpStatus) isAction_Action() {}
+
 var File_proto_actionpb_action_proto protoreflect.FileDescriptor
 
 var file_proto_actionpb_action_proto_rawDesc = []byte{
@@ -336,69 +549,131 @@ var file_proto_actionpb_action_proto_rawDesc = []byte{
 	0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x70, 0x62, 0x2f,
 	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x61,
-	0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5,
-	0x07, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4b,
-	0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52,
-	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x48,
-	0x0a, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x69, 0x6e,
-	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12,
-	0x24, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x48, 0x00, 0x52,
-	0x04, 0x70, 0x6f, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52,
-	0x09, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x6e,
-	0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2a, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x0d, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x65,
-	0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x08, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x5a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x70, 0x62, 0x2f, 0x77, 0x6f,
+	0x72, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x0e, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x4b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x39,
+	0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x10, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x24, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x50, 0x6f, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x53,
+	0x6f, 0x75, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x61, 0x6e, 0x69,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x12, 0x3f, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54,
+	0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x45, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12,
+	0x51, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x48, 0x00, 0x52, 0x13, 0x61,
+	0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x75, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65,
+	0x53, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c,
+	0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x48,
+	0x00, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x05, 0x64, 0x65, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x4e, 0x0a, 0x12, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x76, 0x70,
+	0x46, 0x6c, 0x61, 0x67, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x76, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x48, 0x00, 0x52,
+	0x07, 0x70, 0x76, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x76, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x76, 0x50, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x70, 0x76, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x17, 0x5a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -433,6 +708,21 @@ var file_proto_actionpb_action_proto_goTypes = []interface{}{
 	(*interactpb.InteractWith)(nil),       // 15: messages.InteractWith
 	(*interactpb.InteractQueue)(nil),      // 16: messages.InteractQueue
 	(*transformpb.Teleport)(nil),          // 17: messages.Teleport
+	(*worldpb.WorldTime)(nil),             // 18: messages.WorldTime
+	(*worldpb.Weather)(nil),               // 19: messages.Weather
+	(*accountpb.Login)(nil),               // 20: messages.Login
+	(*accountpb.CharacterList)(nil),       // 21: messages.CharacterList
+	(*accountpb.CreateCharacter)(nil),     // 22: messages.CreateCharacter
+	(*accountpb.SelectCharacter)(nil),     // 23: messages.SelectCharacter
+	(*accountpb.AppearanceCatalogue)(nil), // 24: messages.AppearanceCatalogue
+	(*combatpb.ProjectileSpawn)(nil),      // 25: messages.ProjectileSpawn
+	(*combatpb.ProjectileImpact)(nil),     // 26: messages.ProjectileImpact
+	(*combatpb.Death)(nil),                // 27: messages.Death
+	(*combatpb.Respawn)(nil),              // 28: messages.Respawn
+	(*objectpb.StatusEffect)(nil),         // 29: messages.StatusEffect
+	(*objectpb.StatusEffectRemove)(nil),   // 30: messages.StatusEffectRemove
+	(*combatpb.PvPFlag)(nil),              // 31: messages.PvPFlag
+	(*combatpb.PvPStatus)(nil),            // 32: messages.PvPStatus
 }
 var file_proto_actionpb_action_proto_depIdxs = []int32{
 	1,  // 0: messages.Action.transform:type_name -> messages.Transform
@@ -452,11 +742,26 @@ var file_proto_actionpb_action_proto_depIdxs = []int32{
 	15, // 14: messages.Action.interactWith:type_name -> messages.InteractWith
 	16, // 15: messages.Action.interactQueue:type_name -> messages.InteractQueue
 	17, // 16: messages.Action.teleport:type_name -> messages.Teleport
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	18, // 17: messages.Action.worldTime:type_name -> messages.WorldTime
+	19, // 18: messages.Action.weather:type_name -> messages.Weather
+	20, // 19: messages.Action.login:type_name -> messages.Login
+	21, // 20: messages.Action.characterList:type_name -> messages.CharacterList
+	22, // 21: messages.Action.createCharacter:type_name -> messages.CreateCharacter
+	23, // 22: messages.Action.selectCharacter:type_name -> messages.SelectCharacter
+	24, // 23: messages.Action.appearanceCatalogue:type_name -> messages.AppearanceCatalogue
+	25, // 24: messages.Action.projectileSpawn:type_name -> messages.ProjectileSpawn
+	26, // 25: messages.Action.projectileImpact:type_name -> messages.ProjectileImpact
+	27, // 26: messages.Action.death:type_name -> messages.Death
+	28, // 27: messages.Action.respawn:type_name -> messages.Respawn
+	29, // 28: messages.Action.statusEffect:type_name -> messages.StatusEffect
+	30, // 29: messages.Action.statusEffectRemove:type_name -> messages.StatusEffectRemove
+	31, // 30: messages.Action.pvpFlag:type_name -> messages.PvPFlag
+	32, // 31: messages.Action.pvpStatus:type_name -> messages.PvPStatus
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_proto_actionpb_action_proto_init() }
@@ -496,6 +801,21 @@ func file_proto_actionpb_action_proto_init() {
 		(*Action_InteractWith)(nil),
 		(*Action_InteractQueue)(nil),
 		(*Action_Teleport)(nil),
+		(*Action_WorldTime)(nil),
+		(*Action_Weather)(nil),
+		(*Action_Login)(nil),
+		(*Action_CharacterList)(nil),
+		(*Action_CreateCharacter)(nil),
+		(*Action_SelectCharacter)(nil),
+		(*Action_AppearanceCatalogue)(nil),
+		(*Action_ProjectileSpawn)(nil),
+		(*Action_ProjectileImpact)(nil),
+		(*Action_Death)(nil),
+		(*Action_Respawn)(nil),
+		(*Action_StatusEffect)(nil),
+		(*Action_StatusEffectRemove)(nil),
+		(*Action_PvpFlag)(nil),
+		(*Action_PvpStatus)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
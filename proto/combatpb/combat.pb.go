@@ -0,0 +1,695 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.3
+// source: proto/combatpb/combat.proto
+
+package combatpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	proto "server/proto"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProjectileSpawn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID       string          `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	SourceUuid string          `protobuf:"bytes,2,opt,name=source_uuid,json=sourceUuid,proto3" json:"source_uuid,omitempty"`
+	Resource   string          `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Position   *proto.Vector3M `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Direction  *proto.Vector3M `protobuf:"bytes,5,opt,name=direction,proto3" json:"direction,omitempty"` // Normalized
+	Speed      float32         `protobuf:"fixed32,6,opt,name=speed,proto3" json:"speed,omitempty"`       // Meters per second
+	MaxRange   float32         `protobuf:"fixed32,7,opt,name=max_range,json=maxRange,proto3" json:"max_range,omitempty"`
+}
+
+func (x *ProjectileSpawn) Reset() {
+	*x = ProjectileSpawn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectileSpawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectileSpawn) ProtoMessage() {}
+
+func (x *ProjectileSpawn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectileSpawn.ProtoReflect.Descriptor instead.
+func (*ProjectileSpawn) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectileSpawn) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *ProjectileSpawn) GetSourceUuid() string {
+	if x != nil {
+		return x.SourceUuid
+	}
+	return ""
+}
+
+func (x *ProjectileSpawn) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ProjectileSpawn) GetPosition() *proto.Vector3M {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ProjectileSpawn) GetDirection() *proto.Vector3M {
+	if x != nil {
+		return x.Direction
+	}
+	return nil
+}
+
+func (x *ProjectileSpawn) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ProjectileSpawn) GetMaxRange() float32 {
+	if x != nil {
+		return x.MaxRange
+	}
+	return 0
+}
+
+type ProjectileImpact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID       string          `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Position   *proto.Vector3M `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	TargetUuid string          `protobuf:"bytes,3,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"` // Empty when the projectile hit the ground, an obstacle or flew out of range
+}
+
+func (x *ProjectileImpact) Reset() {
+	*x = ProjectileImpact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectileImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectileImpact) ProtoMessage() {}
+
+func (x *ProjectileImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectileImpact.ProtoReflect.Descriptor instead.
+func (*ProjectileImpact) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProjectileImpact) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *ProjectileImpact) GetPosition() *proto.Vector3M {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ProjectileImpact) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+type RespawnPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "level:teleport"
+	Level    string          `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Position *proto.Vector3M `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *RespawnPoint) Reset() {
+	*x = RespawnPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespawnPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnPoint) ProtoMessage() {}
+
+func (x *RespawnPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnPoint.ProtoReflect.Descriptor instead.
+func (*RespawnPoint) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RespawnPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RespawnPoint) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *RespawnPoint) GetPosition() *proto.Vector3M {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// Sent to the dead player with the respawn choice and to the observers without it
+type Death struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID          string          `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	KillerUuid    string          `protobuf:"bytes,2,opt,name=killer_uuid,json=killerUuid,proto3" json:"killer_uuid,omitempty"`
+	RespawnPoints []*RespawnPoint `protobuf:"bytes,3,rep,name=respawn_points,json=respawnPoints,proto3" json:"respawn_points,omitempty"`
+	RespawnDelay  float32         `protobuf:"fixed32,4,opt,name=respawn_delay,json=respawnDelay,proto3" json:"respawn_delay,omitempty"` // Seconds before a respawn is accepted
+}
+
+func (x *Death) Reset() {
+	*x = Death{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Death) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Death) ProtoMessage() {}
+
+func (x *Death) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Death.ProtoReflect.Descriptor instead.
+func (*Death) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Death) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *Death) GetKillerUuid() string {
+	if x != nil {
+		return x.KillerUuid
+	}
+	return ""
+}
+
+func (x *Death) GetRespawnPoints() []*RespawnPoint {
+	if x != nil {
+		return x.RespawnPoints
+	}
+	return nil
+}
+
+func (x *Death) GetRespawnDelay() float32 {
+	if x != nil {
+		return x.RespawnDelay
+	}
+	return 0
+}
+
+type Respawn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RespawnPoint string `protobuf:"bytes,1,opt,name=respawn_point,json=respawnPoint,proto3" json:"respawn_point,omitempty"`
+}
+
+func (x *Respawn) Reset() {
+	*x = Respawn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Respawn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Respawn) ProtoMessage() {}
+
+func (x *Respawn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Respawn.ProtoReflect.Descriptor instead.
+func (*Respawn) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Respawn) GetRespawnPoint() string {
+	if x != nil {
+		return x.RespawnPoint
+	}
+	return ""
+}
+
+// Sent by the player to opt in or out of PvP on opt-in levels
+type PvPFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsFlagged bool `protobuf:"varint,1,opt,name=is_flagged,json=isFlagged,proto3" json:"is_flagged,omitempty"`
+}
+
+func (x *PvPFlag) Reset() {
+	*x = PvPFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PvPFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PvPFlag) ProtoMessage() {}
+
+func (x *PvPFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PvPFlag.ProtoReflect.Descriptor instead.
+func (*PvPFlag) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PvPFlag) GetIsFlagged() bool {
+	if x != nil {
+		return x.IsFlagged
+	}
+	return false
+}
+
+// Sent to the player and the observers when the PvP state of the player changes
+type PvPStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UUID               string  `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	IsFlagged          bool    `protobuf:"varint,2,opt,name=is_flagged,json=isFlagged,proto3" json:"is_flagged,omitempty"`                               // Opted in or attacked a player recently
+	CombatFlagDuration float32 `protobuf:"fixed32,3,opt,name=combat_flag_duration,json=combatFlagDuration,proto3" json:"combat_flag_duration,omitempty"` // Seconds the flag stays after attacking a player, 0 without the combat flag
+	IsInSafeZone       bool    `protobuf:"varint,4,opt,name=is_in_safe_zone,json=isInSafeZone,proto3" json:"is_in_safe_zone,omitempty"`
+}
+
+func (x *PvPStatus) Reset() {
+	*x = PvPStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_combatpb_combat_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PvPStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PvPStatus) ProtoMessage() {}
+
+func (x *PvPStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_combatpb_combat_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PvPStatus.ProtoReflect.Descriptor instead.
+func (*PvPStatus) Descriptor() ([]byte, []int) {
+	return file_proto_combatpb_combat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PvPStatus) GetUUID() string {
+	if x != nil {
+		return x.UUID
+	}
+	return ""
+}
+
+func (x *PvPStatus) GetIsFlagged() bool {
+	if x != nil {
+		return x.IsFlagged
+	}
+	return false
+}
+
+func (x *PvPStatus) GetCombatFlagDuration() float32 {
+	if x != nil {
+		return x.CombatFlagDuration
+	}
+	return 0
+}
+
+func (x *PvPStatus) GetIsInSafeZone() bool {
+	if x != nil {
+		return x.IsInSafeZone
+	}
+	return false
+}
+
+var File_proto_combatpb_combat_proto protoreflect.FileDescriptor
+
+var file_proto_combatpb_combat_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x70, 0x62,
+	0x2f, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
+	0x55, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x33, 0x4d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x4d, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x77, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2e, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x33, 0x4d, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x75, 0x69, 0x64, 0x22, 0x68,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x33, 0x4d, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x05, 0x44, 0x65, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x69, 0x6c,
+	0x6c, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x2e, 0x0a, 0x07, 0x52,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x07, 0x50,
+	0x76, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x50, 0x76, 0x50, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46,
+	0x6c, 0x61, 0x67, 0x67, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x46, 0x6c, 0x61, 0x67,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x69,
+	0x6e, 0x5f, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x49, 0x6e, 0x53, 0x61, 0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x42,
+	0x17, 0x5a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x62, 0x61, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_combatpb_combat_proto_rawDescOnce sync.Once
+	file_proto_combatpb_combat_proto_rawDescData = file_proto_combatpb_combat_proto_rawDesc
+)
+
+func file_proto_combatpb_combat_proto_rawDescGZIP() []byte {
+	file_proto_combatpb_combat_proto_rawDescOnce.Do(func() {
+		file_proto_combatpb_combat_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_combatpb_combat_proto_rawDescData)
+	})
+	return file_proto_combatpb_combat_proto_rawDescData
+}
+
+var file_proto_combatpb_combat_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_combatpb_combat_proto_goTypes = []interface{}{
+	(*ProjectileSpawn)(nil),  // 0: messages.ProjectileSpawn
+	(*ProjectileImpact)(nil), // 1: messages.ProjectileImpact
+	(*RespawnPoint)(nil),     // 2: messages.RespawnPoint
+	(*Death)(nil),            // 3: messages.Death
+	(*Respawn)(nil),          // 4: messages.Respawn
+	(*PvPFlag)(nil),          // 5: messages.PvPFlag
+	(*PvPStatus)(nil),        // 6: messages.PvPStatus
+	(*proto.Vector3M)(nil),   // 7: messages.Vector3M
+}
+var file_proto_combatpb_combat_proto_depIdxs = []int32{
+	7, // 0: messages.ProjectileSpawn.position:type_name -> messages.Vector3M
+	7, // 1: messages.ProjectileSpawn.direction:type_name -> messages.Vector3M
+	7, // 2: messages.ProjectileImpact.position:type_name -> messages.Vector3M
+	7, // 3: messages.RespawnPoint.position:type_name -> messages.Vector3M
+	2, // 4: messages.Death.respawn_points:type_name -> messages.RespawnPoint
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_combatpb_combat_proto_init() }
+func file_proto_combatpb_combat_proto_init() {
+	if File_proto_combatpb_combat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_combatpb_combat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectileSpawn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectileImpact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespawnPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Death); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Respawn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PvPFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_combatpb_combat_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PvPStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_combatpb_combat_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_combatpb_combat_proto_goTypes,
+		DependencyIndexes: file_proto_combatpb_combat_proto_depIdxs,
+		MessageInfos:      file_proto_combatpb_combat_proto_msgTypes,
+	}.Build()
+	File_proto_combatpb_combat_proto = out.File
+	file_proto_combatpb_combat_proto_rawDesc = nil
+	file_proto_combatpb_combat_proto_goTypes = nil
+	file_proto_combatpb_combat_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.3
+// source: proto/worldpb/world.proto
+
+package worldpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WorldTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hour      float32 `protobuf:"fixed32,1,opt,name=hour,proto3" json:"hour,omitempty"`                            // 0 - 24
+	DayLength float32 `protobuf:"fixed32,2,opt,name=day_length,json=dayLength,proto3" json:"day_length,omitempty"` // real seconds of a full in-game day
+	TimeOfDay string  `protobuf:"bytes,3,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"` // "day", "night"
+}
+
+func (x *WorldTime) Reset() {
+	*x = WorldTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_worldpb_world_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorldTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldTime) ProtoMessage() {}
+
+func (x *WorldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_worldpb_world_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldTime.ProtoReflect.Descriptor instead.
+func (*WorldTime) Descriptor() ([]byte, []int) {
+	return file_proto_worldpb_world_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorldTime) GetHour() float32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *WorldTime) GetDayLength() float32 {
+	if x != nil {
+		return x.DayLength
+	}
+	return 0
+}
+
+func (x *WorldTime) GetTimeOfDay() string {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return ""
+}
+
+type Weather struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Region       string  `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Type         string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // "clear", "rain", "fog", "storm"
+	PreviousType string  `protobuf:"bytes,3,opt,name=previous_type,json=previousType,proto3" json:"previous_type,omitempty"`
+	Transition   float32 `protobuf:"fixed32,4,opt,name=transition,proto3" json:"transition,omitempty"` // seconds to blend from the previous type
+}
+
+func (x *Weather) Reset() {
+	*x = Weather{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_worldpb_world_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Weather) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weather) ProtoMessage() {}
+
+func (x *Weather) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_worldpb_world_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weather.ProtoReflect.Descriptor instead.
+func (*Weather) Descriptor() ([]byte, []int) {
+	return file_proto_worldpb_world_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Weather) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Weather) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Weather) GetPreviousType() string {
+	if x != nil {
+		return x.PreviousType
+	}
+	return ""
+}
+
+func (x *Weather) GetTransition() float32 {
+	if x != nil {
+		return x.Transition
+	}
+	return 0
+}
+
+var File_proto_worldpb_world_proto protoreflect.FileDescriptor
+
+var file_proto_worldpb_world_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x70, 0x62, 0x2f,
+	0x77, 0x6f, 0x72, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x64, 0x61, 0x79, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x44, 0x61, 0x79, 0x22, 0x7a, 0x0a, 0x07, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x16, 0x5a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_worldpb_world_proto_rawDescOnce sync.Once
+	file_proto_worldpb_world_proto_rawDescData = file_proto_worldpb_world_proto_rawDesc
+)
+
+func file_proto_worldpb_world_proto_rawDescGZIP() []byte {
+	file_proto_worldpb_world_proto_rawDescOnce.Do(func() {
+		file_proto_worldpb_world_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_worldpb_world_proto_rawDescData)
+	})
+	return file_proto_worldpb_world_proto_rawDescData
+}
+
+var file_proto_worldpb_world_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_worldpb_world_proto_goTypes = []interface{}{
+	(*WorldTime)(nil), // 0: messages.WorldTime
+	(*Weather)(nil),   // 1: messages.Weather
+}
+var file_proto_worldpb_world_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_worldpb_world_proto_init() }
+func file_proto_worldpb_world_proto_init() {
+	if File_proto_worldpb_world_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_worldpb_world_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorldTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_worldpb_world_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Weather); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_worldpb_world_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_worldpb_world_proto_goTypes,
+		DependencyIndexes: file_proto_worldpb_world_proto_depIdxs,
+		MessageInfos:      file_proto_worldpb_world_proto_msgTypes,
+	}.Build()
+	File_proto_worldpb_world_proto = out.File
+	file_proto_worldpb_world_proto_rawDesc = nil
+	file_proto_worldpb_world_proto_goTypes = nil
+	file_proto_worldpb_world_proto_depIdxs = nil
+}
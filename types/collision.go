@@ -0,0 +1,265 @@
+package types
+
+import (
+	"math"
+	"server/entity"
+)
+
+type Quaternion struct {
+	X float64
+	Y float64
+	Z float64
+	W float64
+}
+
+// Collider is a static collision volume placed in the world
+type Collider struct {
+	Shape       entity.ColliderShape
+	Position    Vector3 // Bottom of the shape
+	Orientation Quaternion
+	HalfExtents Vector3 // Box half size in local space
+	Top         Vector3 // Capsule axis end
+	Radius      float64 // Capsule radius
+}
+
+func (q Quaternion) IsZero() bool {
+	return q == Quaternion{}
+}
+
+func (q Quaternion) Inverse() Quaternion {
+	if q.IsZero() {
+		return q
+	}
+
+	return Quaternion{X: -q.X, Y: -q.Y, Z: -q.Z, W: q.W}
+}
+
+// Rotate applies the rotation to the vector, zero quaternion is treated as identity
+func (q Quaternion) Rotate(v Vector3) Vector3 {
+	if q.IsZero() {
+		return v
+	}
+
+	// t = 2 * cross(q.xyz, v), v' = v + w * t + cross(q.xyz, t)
+	u := Vector3{X: q.X, Y: q.Y, Z: q.Z}
+	t := scaleVector(crossVector(u, v), 2)
+
+	return addVector(addVector(v, scaleVector(t, q.W)), crossVector(u, t))
+}
+
+// GetCollider builds the world space collision volume of a static map object. The shape comes from the entity
+// definition, objects without it use a unit cube scaled by the level object scale. Teleports, objects with zero
+// scale and no definition, and destroyed objects don't collide.
+func (o *GameObject) GetCollider() *Collider {
+	if o.Type != ObjectTypeVariantMapObject || o.Kind == ObjectKindTeleport {
+		return nil
+	}
+
+	if o.Entity.MaxHealth > 0 && o.IsDead() {
+		return nil
+	}
+
+	scale := o.Scale
+	if scale == (Vector3{}) {
+		if o.Entity.Collider == nil {
+			return nil
+		}
+		scale = Vector3{X: 1, Y: 1, Z: 1}
+	}
+
+	shape := o.Entity.Collider
+	if shape == nil {
+		shape = &entity.Collider{Shape: entity.ColliderBox, Width: 1, Height: 1, Length: 1}
+	}
+
+	collider := &Collider{Shape: shape.Shape, Position: o.Position, Orientation: o.Orientation}
+
+	switch shape.Shape {
+	case entity.ColliderCapsule:
+		collider.Radius = float64(shape.Radius) * math.Max(scale.X, scale.Z)
+		collider.Top = addVector(o.Position, o.Orientation.Rotate(Vector3{Y: float64(shape.Height) * scale.Y}))
+	default:
+		collider.HalfExtents = Vector3{
+			X: float64(shape.Width) * scale.X / 2,
+			Y: float64(shape.Height) * scale.Y / 2,
+			Z: float64(shape.Length) * scale.Z / 2,
+		}
+	}
+
+	return collider
+}
+
+// toLocal converts the point into the box space with the origin in the box center
+func (c *Collider) toLocal(point Vector3) Vector3 {
+	local := c.Orientation.Inverse().Rotate(subVector(point, c.Position))
+	local.Y -= c.HalfExtents.Y
+
+	return local
+}
+
+func (c *Collider) fromLocal(direction Vector3) Vector3 {
+	return c.Orientation.Rotate(direction)
+}
+
+// IntersectsSegment is used for line of sight checks
+func (c *Collider) IntersectsSegment(from, to Vector3) bool {
+	if c.Shape == entity.ColliderCapsule {
+		return segmentsDistance(from, to, c.Position, c.Top) <= c.Radius
+	}
+
+	start := c.toLocal(from)
+	end := c.toLocal(to)
+
+	return segmentIntersectsBox(start, end, c.HalfExtents)
+}
+
+// Penetration returns the horizontal push that moves a sphere with the radius out of the collider
+func (c *Collider) Penetration(point Vector3, radius float64) (Vector3, bool) {
+	if c.Shape == entity.ColliderCapsule {
+		closest := closestPointOnSegment(point, c.Position, c.Top)
+		offset := Vector3{X: point.X - closest.X, Z: point.Z - closest.Z}
+		dist := math.Sqrt(offset.X*offset.X + offset.Z*offset.Z)
+		depth := c.Radius + radius - dist
+
+		if depth <= 0 || math.Abs(point.Y-closest.Y) > c.Radius+radius {
+			return Vector3{}, false
+		}
+
+		if dist < 1e-9 {
+			return Vector3{X: depth}, true
+		}
+
+		return scaleVector(offset, depth/dist), true
+	}
+
+	local := c.toLocal(point)
+	depthX := c.HalfExtents.X + radius - math.Abs(local.X)
+	depthY := c.HalfExtents.Y + radius - math.Abs(local.Y)
+	depthZ := c.HalfExtents.Z + radius - math.Abs(local.Z)
+
+	if depthX <= 0 || depthY <= 0 || depthZ <= 0 {
+		return Vector3{}, false
+	}
+
+	push := Vector3{Z: math.Copysign(depthZ, local.Z)}
+	if depthX < depthZ {
+		push = Vector3{X: math.Copysign(depthX, local.X)}
+	}
+
+	push = c.fromLocal(push)
+	push.Y = 0
+
+	return push, true
+}
+
+// segmentIntersectsBox is a slab test of the segment against the box centered in the origin
+func segmentIntersectsBox(from, to, halfExtents Vector3) bool {
+	start := [3]float64{from.X, from.Y, from.Z}
+	direction := [3]float64{to.X - from.X, to.Y - from.Y, to.Z - from.Z}
+	half := [3]float64{halfExtents.X, halfExtents.Y, halfExtents.Z}
+	tMin, tMax := 0.0, 1.0
+
+	for i := 0; i < 3; i++ {
+		if math.Abs(direction[i]) < 1e-9 {
+			if start[i] < -half[i] || start[i] > half[i] {
+				return false
+			}
+			continue
+		}
+
+		t1 := (-half[i] - start[i]) / direction[i]
+		t2 := (half[i] - start[i]) / direction[i]
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+
+		tMin = math.Max(tMin, t1)
+		tMax = math.Min(tMax, t2)
+		if tMin > tMax {
+			return false
+		}
+	}
+
+	return true
+}
+
+func closestPointOnSegment(point, a, b Vector3) Vector3 {
+	ab := subVector(b, a)
+	length := dotVector(ab, ab)
+	if length < 1e-9 {
+		return a
+	}
+
+	t := math.Max(0, math.Min(1, dotVector(subVector(point, a), ab)/length))
+
+	return addVector(a, scaleVector(ab, t))
+}
+
+// segmentsDistance returns the closest distance between segments p1-q1 and p2-q2
+func segmentsDistance(p1, q1, p2, q2 Vector3) float64 {
+	d1 := subVector(q1, p1)
+	d2 := subVector(q2, p2)
+	r := subVector(p1, p2)
+	a := dotVector(d1, d1)
+	e := dotVector(d2, d2)
+	f := dotVector(d2, r)
+
+	var s, t float64
+
+	switch {
+	case a < 1e-9 && e < 1e-9:
+		s, t = 0, 0
+	case a < 1e-9:
+		s, t = 0, math.Max(0, math.Min(1, f/e))
+	default:
+		c := dotVector(d1, r)
+		if e < 1e-9 {
+			s, t = math.Max(0, math.Min(1, -c/a)), 0
+		} else {
+			b := dotVector(d1, d2)
+			denom := a*e - b*b
+			if denom > 1e-9 {
+				s = math.Max(0, math.Min(1, (b*f-c*e)/denom))
+			}
+
+			t = (b*s + f) / e
+			if t < 0 {
+				t, s = 0, math.Max(0, math.Min(1, -c/a))
+			} else if t > 1 {
+				t, s = 1, math.Max(0, math.Min(1, (b-c)/a))
+			}
+		}
+	}
+
+	closest1 := addVector(p1, scaleVector(d1, s))
+	closest2 := addVector(p2, scaleVector(d2, t))
+	diff := subVector(closest1, closest2)
+
+	return math.Sqrt(dotVector(diff, diff))
+}
+
+func addVector(a, b Vector3) Vector3 {
+	return Vector3{X: a.X + b.X, Y: a.Y + b.Y, Z: a.Z + b.Z}
+}
+
+func subVector(a, b Vector3) Vector3 {
+	return Vector3{X: a.X - b.X, Y: a.Y - b.Y, Z: a.Z - b.Z}
+}
+
+func scaleVector(v Vector3, f float64) Vector3 {
+	return Vector3{X: v.X * f, Y: v.Y * f, Z: v.Z * f}
+}
+
+func dotVector(a, b Vector3) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+func crossVector(a, b Vector3) Vector3 {
+	return Vector3{X: a.Y*b.Z - a.Z*b.Y, Y: a.Z*b.X - a.X*b.Z, Z: a.X*b.Y - a.Y*b.X}
+}
+
+// SegmentHitsCapsule checks the segment against an upright capsule standing on the base point
+func SegmentHitsCapsule(from, to, base Vector3, height, radius float64) bool {
+	top := Vector3{X: base.X, Y: base.Y + height, Z: base.Z}
+	return segmentsDistance(from, to, base, top) <= radius
+}
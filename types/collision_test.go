@@ -0,0 +1,187 @@
+package types
+
+import (
+	"math"
+	"server/entity"
+	"testing"
+)
+
+func TestGetColliderFallsBackToScaledBox(t *testing.T) {
+	rock := &GameObject{Type: ObjectTypeVariantMapObject, Position: Vector3{X: 5}, Scale: Vector3{X: 2, Y: 3, Z: 2}}
+	collider := rock.GetCollider()
+	if collider == nil {
+		t.Fatal("scaled object without a Collider definition doesn't collide")
+	}
+	if collider.Shape != entity.ColliderBox || collider.HalfExtents != (Vector3{X: 1, Y: 1.5, Z: 1}) {
+		t.Errorf("fallback collider %+v, want box with half extents 1, 1.5, 1", collider)
+	}
+
+	if !collider.IntersectsSegment(Vector3{X: 0, Y: 1}, Vector3{X: 10, Y: 1}) {
+		t.Error("scaled object doesn't block a segment through it")
+	}
+	if collider.IntersectsSegment(Vector3{X: 0, Y: 4}, Vector3{X: 10, Y: 4}) {
+		t.Error("segment above the scaled object is blocked")
+	}
+}
+
+func TestGetColliderSkipsNonColliding(t *testing.T) {
+	tests := []struct {
+		name   string
+		object *GameObject
+	}{
+		{"zero scale without definition", &GameObject{Type: ObjectTypeVariantMapObject}},
+		{"teleport", &GameObject{Type: ObjectTypeVariantMapObject, Kind: ObjectKindTeleport, Scale: Vector3{X: 1, Y: 1, Z: 1}}},
+		{"not a map object", &GameObject{Type: ObjectTypeNPC, Scale: Vector3{X: 1, Y: 1, Z: 1}}},
+		{"destroyed", &GameObject{
+			Type:   ObjectTypeVariantMapObject,
+			Scale:  Vector3{X: 1, Y: 1, Z: 1},
+			Entity: entity.Entity{MaxHealth: 10, Health: 0},
+		}},
+	}
+
+	for _, test := range tests {
+		if collider := test.object.GetCollider(); collider != nil {
+			t.Errorf("%s: collides with %+v", test.name, collider)
+		}
+	}
+}
+
+func TestGetColliderUsesDefinition(t *testing.T) {
+	tree := &GameObject{
+		Type:        ObjectTypeVariantMapObject,
+		Orientation: Quaternion{W: 1},
+		Entity:      entity.Entity{Collider: &entity.Collider{Shape: entity.ColliderCapsule, Radius: 0.4, Height: 8}},
+	}
+	collider := tree.GetCollider()
+	if collider == nil {
+		t.Fatal("object with a Collider definition doesn't collide")
+	}
+	if math.Abs(collider.Radius-0.4) > 1e-6 || collider.Top.Y != 8 {
+		t.Errorf("unscaled capsule radius %f, top %f, want 0.4 and 8", collider.Radius, collider.Top.Y)
+	}
+}
+
+// Rotations of 90 degrees around Y and 180 degrees around X
+var (
+	yaw90   = Quaternion{Y: math.Sqrt2 / 2, W: math.Sqrt2 / 2}
+	roll180 = Quaternion{X: 1}
+)
+
+func nearVector(a, b Vector3) bool {
+	return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9 && math.Abs(a.Z-b.Z) < 1e-9
+}
+
+func TestQuaternionRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation Quaternion
+		vector   Vector3
+		want     Vector3
+	}{
+		{"zero is identity", Quaternion{}, Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}},
+		{"identity", Quaternion{W: 1}, Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}},
+		{"yaw 90 turns X to -Z", yaw90, Vector3{X: 1}, Vector3{Z: -1}},
+		{"yaw 90 turns Z to X", yaw90, Vector3{Z: 1}, Vector3{X: 1}},
+		{"yaw keeps Y", yaw90, Vector3{Y: 2}, Vector3{Y: 2}},
+		{"roll 180 flips Y", roll180, Vector3{X: 1, Y: 1}, Vector3{X: 1, Y: -1}},
+	}
+
+	for _, test := range tests {
+		if got := test.rotation.Rotate(test.vector); !nearVector(got, test.want) {
+			t.Errorf("%s: %+v, want %+v", test.name, got, test.want)
+		}
+
+		if back := test.rotation.Inverse().Rotate(test.rotation.Rotate(test.vector)); !nearVector(back, test.vector) {
+			t.Errorf("%s: inverse gives %+v, want %+v", test.name, back, test.vector)
+		}
+	}
+}
+
+// A 4x1x1 box standing on the origin, rotated it lies along Z
+func newBox(rotation Quaternion) *Collider {
+	return &Collider{Shape: entity.ColliderBox, Orientation: rotation, HalfExtents: Vector3{X: 2, Y: 0.5, Z: 0.5}}
+}
+
+// A capsule of radius 1 standing on the origin, 4 high
+func newCapsule() *Collider {
+	return &Collider{Shape: entity.ColliderCapsule, Top: Vector3{Y: 4}, Radius: 1}
+}
+
+func TestColliderIntersectsSegment(t *testing.T) {
+	tests := []struct {
+		name     string
+		collider *Collider
+		from, to Vector3
+		want     bool
+	}{
+		{"box along the long side", newBox(Quaternion{}), Vector3{X: -5, Y: 0.5, Z: 0.4}, Vector3{X: 5, Y: 0.5, Z: 0.4}, true},
+		{"box beside the short side", newBox(Quaternion{}), Vector3{X: -5, Y: 0.5, Z: 1.5}, Vector3{X: 5, Y: 0.5, Z: 1.5}, false},
+		{"box above", newBox(Quaternion{}), Vector3{X: -5, Y: 1.1, Z: 0}, Vector3{X: 5, Y: 1.1, Z: 0}, false},
+		{"box segment ends before it", newBox(Quaternion{}), Vector3{X: -5, Y: 0.5}, Vector3{X: -2.1, Y: 0.5}, false},
+		{"box contains the segment", newBox(Quaternion{}), Vector3{X: -1, Y: 0.5}, Vector3{X: 1, Y: 0.5}, true},
+		{"rotated box reaches along Z", newBox(yaw90), Vector3{X: -5, Y: 0.5, Z: 1.5}, Vector3{X: 5, Y: 0.5, Z: 1.5}, true},
+		{"rotated box is narrow along X", newBox(yaw90), Vector3{X: 1.5, Y: 0.5, Z: -5}, Vector3{X: 1.5, Y: 0.5, Z: 5}, false},
+		{"capsule side", newCapsule(), Vector3{X: -5, Y: 2, Z: 0.9}, Vector3{X: 5, Y: 2, Z: 0.9}, true},
+		{"capsule miss", newCapsule(), Vector3{X: -5, Y: 2, Z: 1.1}, Vector3{X: 5, Y: 2, Z: 1.1}, false},
+		{"capsule top cap", newCapsule(), Vector3{X: -5, Y: 4.9}, Vector3{X: 5, Y: 4.9}, true},
+		{"capsule above the cap", newCapsule(), Vector3{X: -5, Y: 5.1}, Vector3{X: 5, Y: 5.1}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.collider.IntersectsSegment(test.from, test.to); got != test.want {
+			t.Errorf("%s: %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestColliderPenetration(t *testing.T) {
+	tests := []struct {
+		name     string
+		collider *Collider
+		point    Vector3
+		radius   float64
+		want     Vector3
+		hit      bool
+	}{
+		{"box pushes out along the shallow X", newBox(Quaternion{}), Vector3{X: 1.8, Y: 0.5}, 0.5, Vector3{X: 0.7}, true},
+		{"box pushes out along the shallow Z", newBox(Quaternion{}), Vector3{X: 0.5, Y: 0.5, Z: -0.3}, 0.5, Vector3{Z: -0.7}, true},
+		{"box out of reach", newBox(Quaternion{}), Vector3{X: 2.6, Y: 0.5}, 0.5, Vector3{}, false},
+		{"box below", newBox(Quaternion{}), Vector3{Y: -0.6}, 0.5, Vector3{}, false},
+		{"rotated box pushes along Z", newBox(yaw90), Vector3{Y: 0.5, Z: 1.8}, 0.5, Vector3{Z: 0.7}, true},
+		{"rotated box out of reach on X", newBox(yaw90), Vector3{X: 1.1, Y: 0.5, Z: 1.8}, 0.5, Vector3{}, false},
+		{"capsule pushes away from the axis", newCapsule(), Vector3{X: 1.2, Y: 2}, 0.5, Vector3{X: 0.3}, true},
+		{"capsule out of reach", newCapsule(), Vector3{X: 3, Y: 2}, 0.5, Vector3{}, false},
+		{"capsule above the cap", newCapsule(), Vector3{Y: 6}, 0.5, Vector3{}, false},
+		{"capsule on the axis", newCapsule(), Vector3{Y: 2}, 0.5, Vector3{X: 1.5}, true},
+	}
+
+	for _, test := range tests {
+		push, hit := test.collider.Penetration(test.point, test.radius)
+		if hit != test.hit || !nearVector(push, test.want) {
+			t.Errorf("%s: %+v %t, want %+v %t", test.name, push, hit, test.want, test.hit)
+		}
+	}
+}
+
+func TestSegmentHitsCapsule(t *testing.T) {
+	base := Vector3{X: 10, Y: 1, Z: 10}
+
+	tests := []struct {
+		name     string
+		from, to Vector3
+		want     bool
+	}{
+		{"through the body", Vector3{X: 5, Y: 2, Z: 10.4}, Vector3{X: 15, Y: 2, Z: 10.4}, true},
+		{"beside the body", Vector3{X: 5, Y: 2, Z: 10.6}, Vector3{X: 15, Y: 2, Z: 10.6}, false},
+		{"over the top cap", Vector3{X: 5, Y: 3.4, Z: 10}, Vector3{X: 15, Y: 3.4, Z: 10}, true},
+		{"above the top cap", Vector3{X: 5, Y: 3.6, Z: 10}, Vector3{X: 15, Y: 3.6, Z: 10}, false},
+		{"ends before the capsule", Vector3{X: 5, Y: 2, Z: 10}, Vector3{X: 9.4, Y: 2, Z: 10}, false},
+		{"diagonal", Vector3{X: 5, Y: 2, Z: 5}, Vector3{X: 15, Y: 2, Z: 15}, true},
+	}
+
+	for _, test := range tests {
+		if got := SegmentHitsCapsule(test.from, test.to, base, 2, 0.5); got != test.want {
+			t.Errorf("%s: %t, want %t", test.name, got, test.want)
+		}
+	}
+}
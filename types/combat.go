@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+)
+
+const (
+	DEFAULT_CRIT_MULTIPLIER float64 = 1.5
+	ARMOR_FACTOR            float64 = 100 // Armor equal to the factor halves the damage
+)
+
+type DamageResult struct {
+	Amount int32
+	IsCrit bool
+}
+
+// CombatCalculator rolls attack damage, a fixed seed gives the same results for the same attacks
+type CombatCalculator struct {
+	sync.Mutex
+	rng *rand.Rand
+}
+
+func NewCombatCalculator(seed int64) *CombatCalculator {
+	return &CombatCalculator{rng: rand.New(rand.NewSource(seed))}
+}
+
+// Calculate returns the damage of one hit: a roll between min and max damage, crit and target mitigation
+func (c *CombatCalculator) Calculate(attacker, target *GameObject) DamageResult {
+	maxDamage := attacker.GetAttackMaxDamage()
+	if maxDamage == nil || *maxDamage <= 0 {
+		return DamageResult{}
+	}
+
+	minDamage := attacker.GetAttackMinDamage()
+	if minDamage <= 0 || minDamage > *maxDamage {
+		minDamage = *maxDamage
+	}
+
+	c.Lock()
+	damage := float64(minDamage + c.rng.Int31n(*maxDamage-minDamage+1))
+	isCrit := c.rng.Float64()*100 < attacker.GetCritChance()
+	c.Unlock()
+
+	if isCrit {
+		damage *= attacker.GetCritMultiplier()
+	}
+
+	damage *= ARMOR_FACTOR / (ARMOR_FACTOR + float64(max(target.Entity.Armor, 0)))
+	damage *= 1 - math.Min(math.Max(float64(target.Entity.Resistance), 0), 100)/100
+
+	// A landed hit always hurts
+	return DamageResult{Amount: max(int32(math.Round(damage)), 1), IsCrit: isCrit}
+}
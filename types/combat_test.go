@@ -0,0 +1,105 @@
+package types
+
+import (
+	"server/entity"
+	"testing"
+)
+
+func newAttacker(weapon entity.Entity) *GameObject {
+	return &GameObject{Entity: entity.Entity{EquippedItems: &entity.EquippedItems{RightHand: weapon}}}
+}
+
+func newTarget(armor int32, resistance float32) *GameObject {
+	return &GameObject{Entity: entity.Entity{Armor: armor, Resistance: resistance}}
+}
+
+func TestCalculateStaysWithinDamageBounds(t *testing.T) {
+	combat := NewCombatCalculator(1)
+	attacker := newAttacker(entity.Entity{AttackMinDamage: 10, AttackDamage: 20})
+	target := newTarget(0, 0)
+
+	seen := map[int32]bool{}
+	for i := 0; i < 1000; i++ {
+		damage := combat.Calculate(attacker, target)
+		if damage.Amount < 10 || damage.Amount > 20 {
+			t.Fatalf("damage %d is out of 10 - 20", damage.Amount)
+		}
+		seen[damage.Amount] = true
+	}
+
+	if !seen[10] || !seen[20] {
+		t.Errorf("bounds are never rolled: min %t, max %t", seen[10], seen[20])
+	}
+}
+
+func TestCalculateWithoutMinDamageDealsMaxDamage(t *testing.T) {
+	combat := NewCombatCalculator(1)
+	damage := combat.Calculate(newAttacker(entity.Entity{AttackDamage: 15}), newTarget(0, 0))
+
+	if damage.Amount != 15 {
+		t.Errorf("damage = %d, want 15", damage.Amount)
+	}
+}
+
+func TestCalculateCritChance(t *testing.T) {
+	combat := NewCombatCalculator(1)
+	target := newTarget(0, 0)
+
+	never := newAttacker(entity.Entity{AttackDamage: 10, CritChance: 0, CritMultiplier: 3})
+	always := newAttacker(entity.Entity{AttackDamage: 10, CritChance: 100, CritMultiplier: 3})
+	defaultMultiplier := newAttacker(entity.Entity{AttackDamage: 10, CritChance: 100})
+
+	for i := 0; i < 100; i++ {
+		if damage := combat.Calculate(never, target); damage.IsCrit || damage.Amount != 10 {
+			t.Fatalf("0%% crit chance: %+v, want 10 without crit", damage)
+		}
+
+		if damage := combat.Calculate(always, target); !damage.IsCrit || damage.Amount != 30 {
+			t.Fatalf("100%% crit chance: %+v, want crit of 30", damage)
+		}
+
+		if damage := combat.Calculate(defaultMultiplier, target); !damage.IsCrit || damage.Amount != 15 {
+			t.Fatalf("default multiplier: %+v, want crit of 15", damage)
+		}
+	}
+}
+
+func TestCalculateMitigation(t *testing.T) {
+	combat := NewCombatCalculator(1)
+	attacker := newAttacker(entity.Entity{AttackDamage: 100})
+
+	tests := []struct {
+		name       string
+		armor      int32
+		resistance float32
+		want       int32
+	}{
+		{"no armor", 0, 0, 100},
+		{"armor equal to the factor halves the damage", int32(ARMOR_FACTOR), 0, 50},
+		{"triple armor leaves a quarter", int32(ARMOR_FACTOR) * 3, 0, 25},
+		{"resistance", 0, 20, 80},
+		{"armor and resistance", int32(ARMOR_FACTOR), 50, 25},
+		{"full resistance still hurts", 0, 100, 1},
+	}
+
+	for _, test := range tests {
+		if damage := combat.Calculate(attacker, newTarget(test.armor, test.resistance)); damage.Amount != test.want {
+			t.Errorf("%s: damage = %d, want %d", test.name, damage.Amount, test.want)
+		}
+	}
+}
+
+func TestCalculateIsReproducibleWithSeed(t *testing.T) {
+	first := NewCombatCalculator(42)
+	second := NewCombatCalculator(42)
+	attacker := newAttacker(entity.Entity{AttackMinDamage: 1, AttackDamage: 100, CritChance: 50})
+	target := newTarget(10, 5)
+
+	for i := 0; i < 100; i++ {
+		a := first.Calculate(attacker, target)
+		b := second.Calculate(attacker, target)
+		if a != b {
+			t.Fatalf("roll %d differs: %+v and %+v", i, a, b)
+		}
+	}
+}
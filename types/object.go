@@ -27,6 +27,7 @@ const (
 	ObjectKindTeleport = 2
 	ObjectKindTree     = 3
 	ObjectKindOre      = 4
+	ObjectKindRegion   = 5 // Box area of the level, meta is the region type
 )
 
 type EventPayloadOptions struct {
@@ -47,16 +48,21 @@ type NextVariation struct {
 type GameObject struct {
 	entity.Entity
 
-	UUID string
-	Node *Node
+	UUID      string
+	Node      *Node
+	WorldName string // Level or instance the object is placed in
 
 	Kind          ObjectKind
 	Position      Vector3
 	Rotation      Vector3
+	Scale         Vector3
+	Orientation   Quaternion // Level object rotation
 	PositionSpawn Vector3
 	RotationSpawn Vector3
 
-	Type ObjectType
+	Type             ObjectType
+	Surface          SurfaceType // Ground surface under the object
+	LastStepPosition *Vector3    // Position of the last footstep sound
 
 	VariationIndex int32
 
@@ -68,8 +74,12 @@ type GameObject struct {
 
 	CurrentAnimation        *string
 	AttackTargetUUID        string
+	Threat                  *ThreatTable   // NPC attack target priorities
+	Effects                 *StatusEffects // Active buffs and debuffs
 	AttackAttempts          int32
-	IsReturningInProgress   bool       // Return to spawn point for NPC
+	IsReturningInProgress   bool // Return to spawn point for NPC, evading attacks and healing
+	EvadeStartTime          *time.Time
+	LastEvadeHealTime       *time.Time
 	NextDestinationTime     *time.Time // Next time for destination set
 	NextAttackTime          *time.Time // Next time for attack set
 	NextStepTime            *time.Time // Timer for next step
@@ -77,6 +87,69 @@ type GameObject struct {
 	NextSpawnTime           *time.Time
 	NextVariation           *NextVariation
 	DestroyTime             *time.Time // Time to destroy object (loot, etc.)
+	ArrivalTeleport         string     // Teleport the object arrived at, ignored until the object walks out of it
+
+	History *PositionHistory // Recent positions for lag compensation
+
+	DeathTime        *time.Time // Player is a corpse until respawn
+	UnlockedRespawns []string   // "level:teleport" respawn points the player has visited
+
+	IsPvPOptIn        bool       // Player flagged themselves for PvP
+	PvPCombatFlagTime *time.Time // Player attacked another player, the flag stays until it expires
+	IsInSafeZone      bool
+}
+
+func (o *GameObject) GetThreat() *ThreatTable {
+	if o.Threat == nil {
+		o.Threat = NewThreatTable()
+	}
+
+	return o.Threat
+}
+
+func (o *GameObject) GetEffects() *StatusEffects {
+	if o.Effects == nil {
+		o.Effects = NewStatusEffects()
+	}
+
+	return o.Effects
+}
+
+func (o *GameObject) IsStunned() bool {
+	return o.Effects != nil && o.Effects.IsStunned()
+}
+
+// GetDamageTakenModifier returns the incoming damage multiplier of the active effects
+func (o *GameObject) GetDamageTakenModifier() float64 {
+	if o.Effects == nil {
+		return 1
+	}
+
+	return o.Effects.GetDamageTakenModifier()
+}
+
+// IsPvPFlagged checks the opt in and the combat flag, guards only attack flagged players
+func (o *GameObject) IsPvPFlagged() bool {
+	return o.IsPvPOptIn || (o.PvPCombatFlagTime != nil && time.Now().Before(*o.PvPCombatFlagTime))
+}
+
+func (o *GameObject) RecordPosition() {
+	if o.History == nil {
+		o.History = &PositionHistory{}
+	}
+
+	o.History.Record(time.Now(), o.Position)
+}
+
+// GetPositionAt returns where the object was at the time, the current position when there is no history
+func (o *GameObject) GetPositionAt(t time.Time) Vector3 {
+	if o.History != nil {
+		if position, ok := o.History.At(t); ok {
+			return position
+		}
+	}
+
+	return o.Position
 }
 
 func (o *GameObject) SetNextTravelTime() {
@@ -157,12 +230,18 @@ func (o *GameObject) TakeDamage(amount int32) {
 	if o.Entity.Health <= 0 {
 		o.Entity.Health = 0
 
+		// Clients drop the effects of dead objects on their own
+		if o.Effects != nil {
+			o.Effects.Clear()
+		}
+
 		// Release current agro, path and respawn
 		if o.Type == ObjectTypeNPC {
 			o.ScheduleRespawn()
 			o.TargetPosition = nil
 			o.AttackTargetUUID = ""
 			o.Path = nil
+			o.GetThreat().Clear()
 		}
 
 	}
@@ -203,18 +282,69 @@ func (o *GameObject) GetAttackMaxDamage() *int32 {
 	return nil
 }
 
+// GetAttackWeapon returns the right hand item, or the entity itself for NPCs fighting without weapons
+func (o *GameObject) GetAttackWeapon() *entity.Entity {
+	if o.Entity.EquippedItems != nil && o.Entity.EquippedItems.RightHand.AttackDamage > 0 {
+		return &o.Entity.EquippedItems.RightHand
+	}
+
+	return &o.Entity
+}
+
+func (o *GameObject) GetAttackMinDamage() int32 {
+	if o.Entity.EquippedItems != nil && o.Entity.EquippedItems.RightHand.AttackDamage > 0 {
+		return o.Entity.EquippedItems.RightHand.AttackMinDamage
+	}
+
+	return o.Entity.AttackMinDamage
+}
+
+func (o *GameObject) GetCritChance() float64 {
+	if o.Entity.EquippedItems != nil && o.Entity.EquippedItems.RightHand.AttackDamage > 0 {
+		return float64(o.Entity.EquippedItems.RightHand.CritChance)
+	}
+
+	return float64(o.Entity.CritChance)
+}
+
+func (o *GameObject) GetCritMultiplier() float64 {
+	multiplier := o.Entity.CritMultiplier
+	if o.Entity.EquippedItems != nil && o.Entity.EquippedItems.RightHand.AttackDamage > 0 {
+		multiplier = o.Entity.EquippedItems.RightHand.CritMultiplier
+	}
+
+	if multiplier == 0 {
+		return DEFAULT_CRIT_MULTIPLIER
+	}
+
+	return float64(multiplier)
+}
+
+// GetAttackSpeed returns seconds between attacks modified by the active effects
 func (o *GameObject) GetAttackSpeed() *float64 {
-	if o.Entity.EquippedItems.RightHand.AttackSpeed > 0 {
-		attackSpeed := float64(o.Entity.EquippedItems.RightHand.AttackSpeed)
-		return &attackSpeed
+	var attackSpeed float64
+	switch {
+	case o.Entity.EquippedItems.RightHand.AttackSpeed > 0:
+		attackSpeed = float64(o.Entity.EquippedItems.RightHand.AttackSpeed)
+	case o.Entity.AttackSpeed > 0:
+		attackSpeed = float64(o.Entity.AttackSpeed)
+	default:
+		return nil
 	}
 
-	if o.Entity.AttackSpeed > 0 {
-		attackSpeed := float64(o.Entity.AttackSpeed)
-		return &attackSpeed
+	if o.Effects != nil {
+		attackSpeed /= o.Effects.GetAttackSpeedModifier()
 	}
 
-	return nil
+	return &attackSpeed
+}
+
+func (o *GameObject) IsRangedAttack() bool {
+	if o.Entity.EquippedItems == nil {
+		return false
+	}
+
+	return o.Entity.EquippedItems.RightHand.Type == entity.TypePistol
 }
 
 func (o *GameObject) ReleaseAttack() {
@@ -259,6 +389,26 @@ func (o *GameObject) LookAt(target *GameObject) Vector3 {
 	return rotation
 }
 
+// GetSpeed returns the walking speed modified by the ground surface and the active effects. Only the movement
+// the server simulates is modified: players report the speed they walk with on the client, it includes the terrain
+// and the server doesn't enforce slows on them.
+func (o *GameObject) GetSpeed() float32 {
+	if o.Type != ObjectTypeNPC {
+		return o.Speed
+	}
+
+	speed := o.Speed
+	if modifier, ok := SurfaceSpeedModifiers[o.Surface]; ok {
+		speed *= modifier
+	}
+
+	if o.Effects != nil {
+		speed *= float32(o.Effects.GetSpeedModifier())
+	}
+
+	return speed
+}
+
 func (o *GameObject) IsDead() bool {
 	return o.Entity.Health <= 0
 }
@@ -323,7 +473,7 @@ func (o *GameObject) MoveNPCWithWaypoints() (bool, bool) {
 	if len(o.Path) > 0 {
 		nextNode := o.Path[0]
 		distance := math.Sqrt(math.Pow(float64(nextNode[0]-node[0]), 2) + math.Pow(float64(nextNode[2]-node[2]), 2))
-		sleepTime := int64(distance/float64(o.Speed)*1000.0) - 20 // 20ms for processing
+		sleepTime := int64(distance/float64(o.GetSpeed())*1000.0) - 20 // 20ms for processing
 		nextStep := time.Now().Add(time.Duration(sleepTime) * time.Millisecond)
 		o.NextStepTime = &nextStep
 
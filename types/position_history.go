@@ -0,0 +1,67 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+const POSITION_HISTORY_SIZE = 64 // About 2.5 seconds of movement updates at 25 per second
+
+type PositionSample struct {
+	Time     time.Time
+	Position Vector3
+}
+
+// PositionHistory is a ring buffer of the recent positions used to rewind objects for hit validation
+type PositionHistory struct {
+	sync.Mutex
+	samples [POSITION_HISTORY_SIZE]PositionSample
+	next    int
+	count   int
+}
+
+func (h *PositionHistory) Record(t time.Time, position Vector3) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.samples[h.next] = PositionSample{Time: t, Position: position}
+	h.next = (h.next + 1) % POSITION_HISTORY_SIZE
+	h.count = min(h.count+1, POSITION_HISTORY_SIZE)
+}
+
+// At returns the position interpolated between the samples around the time,
+// false when the history doesn't reach back that far
+func (h *PositionHistory) At(t time.Time) (Vector3, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.count == 0 {
+		return Vector3{}, false
+	}
+
+	// Walk from the newest sample back in time
+	newer := h.samples[(h.next-1+POSITION_HISTORY_SIZE)%POSITION_HISTORY_SIZE]
+	if !t.Before(newer.Time) {
+		return newer.Position, true
+	}
+
+	for i := 2; i <= h.count; i++ {
+		older := h.samples[(h.next-i+POSITION_HISTORY_SIZE)%POSITION_HISTORY_SIZE]
+		if !t.Before(older.Time) {
+			span := newer.Time.Sub(older.Time).Seconds()
+			if span == 0 {
+				return older.Position, true
+			}
+
+			f := t.Sub(older.Time).Seconds() / span
+			return Vector3{
+				X: older.Position.X + (newer.Position.X-older.Position.X)*f,
+				Y: older.Position.Y + (newer.Position.Y-older.Position.Y)*f,
+				Z: older.Position.Z + (newer.Position.Z-older.Position.Z)*f,
+			}, true
+		}
+		newer = older
+	}
+
+	return Vector3{}, false
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPositionHistoryAt(t *testing.T) {
+	start := time.Now()
+	history := &PositionHistory{}
+
+	if _, ok := history.At(start); ok {
+		t.Fatal("empty history returns a position")
+	}
+
+	// Moves 1 meter along X every 100ms
+	for i := 0; i < 5; i++ {
+		history.Record(start.Add(time.Duration(i)*100*time.Millisecond), Vector3{X: float64(i)})
+	}
+
+	tests := []struct {
+		name   string
+		at     time.Duration
+		want   float64
+		wantOk bool
+	}{
+		{"on a sample", 200 * time.Millisecond, 2, true},
+		{"between samples", 250 * time.Millisecond, 2.5, true},
+		{"oldest sample", 0, 0, true},
+		{"newer than the newest sample", time.Second, 4, true},
+		{"older than the buffer", -time.Millisecond, 0, false},
+	}
+
+	for _, test := range tests {
+		position, ok := history.At(start.Add(test.at))
+		if ok != test.wantOk || !nearFloat(position.X, test.want) {
+			t.Errorf("%s: %f %t, want %f %t", test.name, position.X, ok, test.want, test.wantOk)
+		}
+	}
+}
+
+func TestPositionHistoryWrapAround(t *testing.T) {
+	start := time.Now()
+	history := &PositionHistory{}
+
+	total := POSITION_HISTORY_SIZE + 10
+	for i := 0; i < total; i++ {
+		history.Record(start.Add(time.Duration(i)*time.Second), Vector3{Z: float64(i)})
+	}
+
+	// The first samples are overwritten
+	if _, ok := history.At(start.Add(5 * time.Second)); ok {
+		t.Error("overwritten sample is still returned")
+	}
+
+	oldest := total - POSITION_HISTORY_SIZE
+	if position, ok := history.At(start.Add(time.Duration(oldest) * time.Second)); !ok || position.Z != float64(oldest) {
+		t.Errorf("oldest kept sample: %f %t, want %d", position.Z, ok, oldest)
+	}
+
+	// Interpolates across the end of the ring buffer
+	at := start.Add(time.Duration(POSITION_HISTORY_SIZE-1)*time.Second + 500*time.Millisecond)
+	if position, ok := history.At(at); !ok || !nearFloat(position.Z, float64(POSITION_HISTORY_SIZE)-0.5) {
+		t.Errorf("across the buffer end: %f %t, want %f", position.Z, ok, float64(POSITION_HISTORY_SIZE)-0.5)
+	}
+}
+
+func nearFloat(a, b float64) bool {
+	return a-b < 1e-9 && b-a < 1e-9
+}
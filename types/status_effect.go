@@ -0,0 +1,168 @@
+package types
+
+import (
+	"math"
+	"server/entity"
+	"sync"
+	"time"
+)
+
+// ActiveEffect is a status effect currently applied to an object
+type ActiveEffect struct {
+	Effect       entity.StatusEffect
+	Source       *GameObject // Object which applied the effect, damage ticks are dealt on its behalf
+	Stacks       int
+	ExpireTime   time.Time
+	NextTickTime time.Time
+}
+
+func (e *ActiveEffect) GetRemaining(now time.Time) time.Duration {
+	return max(e.ExpireTime.Sub(now), 0)
+}
+
+// EffectTick is a damage or heal tick of an active effect, negative amount heals
+type EffectTick struct {
+	Effect *ActiveEffect
+	Amount int32
+}
+
+// StatusEffects holds active effects of an object by effect name
+type StatusEffects struct {
+	sync.Mutex
+	effects map[string]*ActiveEffect
+}
+
+func NewStatusEffects() *StatusEffects {
+	return &StatusEffects{effects: map[string]*ActiveEffect{}}
+}
+
+// Apply adds the effect following its stacking rule, returns a copy of the active effect and false when nothing changed
+func (s *StatusEffects) Apply(effect entity.StatusEffect, source *GameObject, now time.Time) (ActiveEffect, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	duration := time.Duration(float64(effect.Duration) * float64(time.Second))
+
+	active, ok := s.effects[effect.Name]
+	if !ok {
+		active = &ActiveEffect{
+			Effect:       effect,
+			Source:       source,
+			Stacks:       1,
+			ExpireTime:   now.Add(duration),
+			NextTickTime: now.Add(getTickInterval(effect)),
+		}
+		s.effects[effect.Name] = active
+
+		return *active, true
+	}
+
+	switch effect.Stacking {
+	case entity.StackingIgnore:
+		return *active, false
+	case entity.StackingStack:
+		active.Stacks = min(active.Stacks+1, max(effect.MaxStacks, 1))
+	}
+
+	active.Effect = effect
+	active.Source = source
+	active.ExpireTime = now.Add(duration)
+
+	return *active, true
+}
+
+// Tick returns the damage ticks due since the previous call and removes the expired effects
+func (s *StatusEffects) Tick(now time.Time) ([]EffectTick, []ActiveEffect) {
+	s.Lock()
+	defer s.Unlock()
+
+	var ticks []EffectTick
+	var expired []ActiveEffect
+
+	for name, active := range s.effects {
+		interval := getTickInterval(active.Effect)
+		if interval > 0 && active.Effect.TickDamage != 0 {
+			// Catch up every tick missed by a slow zone tick, the last one lands on the expire time
+			for !active.NextTickTime.After(now) && !active.NextTickTime.After(active.ExpireTime) {
+				ticks = append(ticks, EffectTick{Effect: active, Amount: active.Effect.TickDamage * int32(active.Stacks)})
+				active.NextTickTime = active.NextTickTime.Add(interval)
+			}
+		}
+
+		if !active.ExpireTime.After(now) {
+			expired = append(expired, *active)
+			delete(s.effects, name)
+		}
+	}
+
+	return ticks, expired
+}
+
+func (s *StatusEffects) Clear() []ActiveEffect {
+	s.Lock()
+	defer s.Unlock()
+
+	removed := make([]ActiveEffect, 0, len(s.effects))
+	for _, active := range s.effects {
+		removed = append(removed, *active)
+	}
+	s.effects = map[string]*ActiveEffect{}
+
+	return removed
+}
+
+func (s *StatusEffects) GetAll() []ActiveEffect {
+	s.Lock()
+	defer s.Unlock()
+
+	effects := make([]ActiveEffect, 0, len(s.effects))
+	for _, active := range s.effects {
+		effects = append(effects, *active)
+	}
+
+	return effects
+}
+
+func (s *StatusEffects) IsStunned() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, active := range s.effects {
+		if active.Effect.IsStun {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *StatusEffects) GetSpeedModifier() float64 {
+	return s.getModifier(func(effect entity.StatusEffect) float32 { return effect.SpeedModifier })
+}
+
+func (s *StatusEffects) GetAttackSpeedModifier() float64 {
+	return s.getModifier(func(effect entity.StatusEffect) float32 { return effect.AttackSpeedModifier })
+}
+
+func (s *StatusEffects) GetDamageTakenModifier() float64 {
+	return s.getModifier(func(effect entity.StatusEffect) float32 { return effect.DamageTakenModifier })
+}
+
+// getModifier multiplies the modifier of every effect, once per stack
+func (s *StatusEffects) getModifier(field func(entity.StatusEffect) float32) float64 {
+	s.Lock()
+	defer s.Unlock()
+
+	modifier := 1.0
+	for _, active := range s.effects {
+		if value := field(active.Effect); value > 0 {
+			modifier *= math.Pow(float64(value), float64(active.Stacks))
+		}
+	}
+
+	return modifier
+}
+
+func getTickInterval(effect entity.StatusEffect) time.Duration {
+	return time.Duration(float64(effect.TickInterval) * float64(time.Second))
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"math"
+	"server/entity"
+	"testing"
+	"time"
+)
+
+func TestStatusEffectsApplyStacking(t *testing.T) {
+	tests := []struct {
+		name        string
+		stacking    entity.EffectStacking
+		maxStacks   int
+		applies     int
+		wantStacks  int
+		wantChanged bool // Result of the last apply
+	}{
+		{"refresh keeps a single stack", entity.StackingRefresh, 5, 3, 1, true},
+		{"empty stacking refreshes", "", 5, 3, 1, true},
+		{"stack adds stacks", entity.StackingStack, 5, 3, 3, true},
+		{"stack stops at MaxStacks", entity.StackingStack, 2, 5, 2, true},
+		{"stack without MaxStacks is a single stack", entity.StackingStack, 0, 3, 1, true},
+		{"ignore keeps the first application", entity.StackingIgnore, 5, 3, 1, false},
+	}
+
+	for _, test := range tests {
+		effects := NewStatusEffects()
+		effect := entity.StatusEffect{Name: "poison", Duration: 10, Stacking: test.stacking, MaxStacks: test.maxStacks}
+		start := time.Now()
+
+		var active ActiveEffect
+		var changed bool
+		for i := 0; i < test.applies; i++ {
+			active, changed = effects.Apply(effect, nil, start.Add(time.Duration(i)*time.Second))
+		}
+
+		if active.Stacks != test.wantStacks || changed != test.wantChanged {
+			t.Errorf("%s: stacks %d changed %t, want %d %t", test.name, active.Stacks, changed, test.wantStacks, test.wantChanged)
+		}
+
+		// Refresh and stack restart the duration from the last application, ignore keeps the first one
+		wantExpire := start.Add(time.Duration(test.applies-1) * time.Second).Add(10 * time.Second)
+		if test.stacking == entity.StackingIgnore {
+			wantExpire = start.Add(10 * time.Second)
+		}
+		if !active.ExpireTime.Equal(wantExpire) {
+			t.Errorf("%s: expires in %s, want %s", test.name, active.ExpireTime.Sub(start), wantExpire.Sub(start))
+		}
+	}
+}
+
+func TestStatusEffectsTick(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name        string
+		effect      entity.StatusEffect
+		stacks      int
+		at          time.Duration
+		wantTicks   []int32
+		wantExpired bool
+	}{
+		{"no tick before the interval", entity.StatusEffect{Duration: 5, TickInterval: 1, TickDamage: 3}, 1, 500 * time.Millisecond, nil, false},
+		{"single tick", entity.StatusEffect{Duration: 5, TickInterval: 1, TickDamage: 3}, 1, time.Second, []int32{3}, false},
+		{"missed ticks are caught up", entity.StatusEffect{Duration: 5, TickInterval: 1, TickDamage: 3}, 1, 3500 * time.Millisecond, []int32{3, 3, 3}, false},
+		{"damage per stack", entity.StatusEffect{Duration: 5, TickInterval: 1, TickDamage: 3, Stacking: entity.StackingStack, MaxStacks: 3}, 2, time.Second, []int32{6}, false},
+		{"negative damage heals", entity.StatusEffect{Duration: 5, TickInterval: 1, TickDamage: -4}, 1, time.Second, []int32{-4}, false},
+		{"last tick lands on expiry", entity.StatusEffect{Duration: 3, TickInterval: 1, TickDamage: 1}, 1, time.Hour, []int32{1, 1, 1}, true},
+		{"without interval doesn't tick", entity.StatusEffect{Duration: 3, TickDamage: 1}, 1, 2 * time.Second, nil, false},
+		{"expires", entity.StatusEffect{Duration: 3, SpeedModifier: 0.5}, 1, 3 * time.Second, nil, true},
+	}
+
+	for _, test := range tests {
+		effects := NewStatusEffects()
+		test.effect.Name = "effect"
+		for i := 0; i < test.stacks; i++ {
+			effects.Apply(test.effect, nil, start)
+		}
+
+		ticks, expired := effects.Tick(start.Add(test.at))
+
+		var amounts []int32
+		for _, tick := range ticks {
+			amounts = append(amounts, tick.Amount)
+		}
+
+		if len(amounts) != len(test.wantTicks) {
+			t.Errorf("%s: ticks %v, want %v", test.name, amounts, test.wantTicks)
+		} else {
+			for i := range amounts {
+				if amounts[i] != test.wantTicks[i] {
+					t.Errorf("%s: ticks %v, want %v", test.name, amounts, test.wantTicks)
+					break
+				}
+			}
+		}
+
+		if isExpired := len(expired) == 1; isExpired != test.wantExpired {
+			t.Errorf("%s: expired %t, want %t", test.name, isExpired, test.wantExpired)
+		}
+		if test.wantExpired && len(effects.GetAll()) != 0 {
+			t.Errorf("%s: expired effect is still active", test.name)
+		}
+	}
+}
+
+func TestStatusEffectsTickDoesNotRepeat(t *testing.T) {
+	effects := NewStatusEffects()
+	start := time.Now()
+	effects.Apply(entity.StatusEffect{Name: "bleed", Duration: 10, TickInterval: 1, TickDamage: 2}, nil, start)
+
+	if ticks, _ := effects.Tick(start.Add(2 * time.Second)); len(ticks) != 2 {
+		t.Fatalf("ticks = %d, want 2", len(ticks))
+	}
+	if ticks, _ := effects.Tick(start.Add(2 * time.Second)); len(ticks) != 0 {
+		t.Errorf("the same ticks are dealt twice: %d", len(ticks))
+	}
+}
+
+func TestStatusEffectsModifiers(t *testing.T) {
+	now := time.Now()
+	slow := entity.StatusEffect{Name: "slow", Duration: 10, SpeedModifier: 0.5, Stacking: entity.StackingStack, MaxStacks: 3}
+	chill := entity.StatusEffect{Name: "chill", Duration: 10, SpeedModifier: 0.8, DamageTakenModifier: 1.5}
+	haste := entity.StatusEffect{Name: "haste", Duration: 10, AttackSpeedModifier: 2}
+
+	tests := []struct {
+		name                                   string
+		effects                                []entity.StatusEffect
+		wantSpeed, wantAttackSpeed, wantDamage float64
+	}{
+		{"no effects", nil, 1, 1, 1},
+		{"single effect", []entity.StatusEffect{chill}, 0.8, 1, 1.5},
+		{"stacks multiply", []entity.StatusEffect{slow, slow}, 0.25, 1, 1},
+		{"effects multiply", []entity.StatusEffect{slow, chill, haste}, 0.4, 2, 1.5},
+	}
+
+	for _, test := range tests {
+		effects := NewStatusEffects()
+		for _, effect := range test.effects {
+			effects.Apply(effect, nil, now)
+		}
+
+		speed, attackSpeed, damage := effects.GetSpeedModifier(), effects.GetAttackSpeedModifier(), effects.GetDamageTakenModifier()
+		if math.Abs(speed-test.wantSpeed) > 1e-6 || math.Abs(attackSpeed-test.wantAttackSpeed) > 1e-6 || math.Abs(damage-test.wantDamage) > 1e-6 {
+			t.Errorf("%s: speed %f attack speed %f damage taken %f, want %f %f %f",
+				test.name, speed, attackSpeed, damage, test.wantSpeed, test.wantAttackSpeed, test.wantDamage)
+		}
+	}
+}
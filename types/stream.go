@@ -9,6 +9,7 @@ type TeleportObject struct {
 	Object   *GameObject
 	Position Vector3
 	Rotation Vector3
+	Level    string // Level to load on the client, empty for the same level
 }
 
 type Animation struct {
@@ -32,9 +33,10 @@ type Damage struct {
 }
 
 type BroadcastSound struct {
-	Resource string
-	Position Vector3
-	Volume   float32
+	Resource  string
+	Position  Vector3
+	Volume    float32
+	WorldName string
 }
 
 type DestroyObject struct {
@@ -48,3 +50,9 @@ type SpawnObject struct {
 type InteractQueue struct {
 	Object *GameObject
 }
+
+type StatusEffectUpdate struct {
+	Object    *GameObject
+	Effect    ActiveEffect
+	IsRemoved bool
+}
@@ -0,0 +1,24 @@
+package types
+
+type SurfaceType = string
+
+const (
+	SurfaceDefault SurfaceType = "default"
+	SurfaceGrass   SurfaceType = "grass"
+	SurfaceDirt    SurfaceType = "dirt"
+	SurfaceSand    SurfaceType = "sand"
+	SurfaceRock    SurfaceType = "rock"
+)
+
+// SurfaceSpeedModifiers multiply the walking speed, surfaces not listed keep the speed
+var SurfaceSpeedModifiers = map[SurfaceType]float32{
+	SurfaceSand: 0.8,
+	SurfaceRock: 0.9,
+}
+
+var SurfaceFootstepSounds = map[SurfaceType]string{
+	SurfaceGrass: "Footsteps/grass",
+	SurfaceDirt:  "Footsteps/dirt",
+	SurfaceSand:  "Footsteps/sand",
+	SurfaceRock:  "Footsteps/rock",
+}
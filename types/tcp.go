@@ -11,10 +11,12 @@ import (
 )
 
 type TCPClient struct {
-	Conn   *net.Conn
-	UUID   string
-	Writer *bufio.Writer
-	Send   chan *actionpb.Action
+	Conn      *net.Conn
+	UUID      string
+	Account   string // Set after login
+	Character string // Set after character select, the player is in the world
+	Writer    *bufio.Writer
+	Send      chan *actionpb.Action
 }
 
 func (c *TCPClient) ProcessSenderChannel() {
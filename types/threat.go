@@ -0,0 +1,121 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+const MIN_THREAT float64 = 0.01 // Decayed entries below it are forgotten
+
+// ThreatTable accumulates how much an NPC wants to attack every player
+type ThreatTable struct {
+	sync.Mutex
+	threat    map[string]float64
+	lastDecay time.Time
+}
+
+func NewThreatTable() *ThreatTable {
+	return &ThreatTable{threat: map[string]float64{}}
+}
+
+func (t *ThreatTable) Add(uuid string, amount float64) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.threat[uuid] += amount
+}
+
+func (t *ThreatTable) Get(uuid string) float64 {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.threat[uuid]
+}
+
+func (t *ThreatTable) Has(uuid string) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	_, ok := t.threat[uuid]
+	return ok
+}
+
+func (t *ThreatTable) Remove(uuid string) {
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.threat, uuid)
+}
+
+func (t *ThreatTable) Clear() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.threat = map[string]float64{}
+}
+
+func (t *ThreatTable) IsEmpty() bool {
+	t.Lock()
+	defer t.Unlock()
+
+	return len(t.threat) == 0
+}
+
+func (t *ThreatTable) GetUUIDs() []string {
+	t.Lock()
+	defer t.Unlock()
+
+	uuids := make([]string, 0, len(t.threat))
+	for uuid := range t.threat {
+		uuids = append(uuids, uuid)
+	}
+
+	return uuids
+}
+
+// Decay reduces every entry by the rate per second since the previous call and returns the elapsed seconds
+func (t *ThreatTable) Decay(now time.Time, ratePerSecond float64) float64 {
+	t.Lock()
+	defer t.Unlock()
+
+	elapsed := 0.0
+	if !t.lastDecay.IsZero() {
+		elapsed = now.Sub(t.lastDecay).Seconds()
+	}
+	t.lastDecay = now
+
+	factor := max(1-ratePerSecond*elapsed, 0)
+	for uuid, threat := range t.threat {
+		threat *= factor
+		if threat < MIN_THREAT {
+			delete(t.threat, uuid)
+			continue
+		}
+		t.threat[uuid] = threat
+	}
+
+	return elapsed
+}
+
+// SelectTarget returns the player with the highest threat, the current target is kept
+// until another one exceeds its threat by the margin (0.1 = 10%) to avoid flipping between targets
+func (t *ThreatTable) SelectTarget(current string, margin float64) string {
+	t.Lock()
+	defer t.Unlock()
+
+	top := ""
+	topThreat := 0.0
+	for uuid, threat := range t.threat {
+		if threat > topThreat || (threat == topThreat && uuid < top) {
+			top = uuid
+			topThreat = threat
+		}
+	}
+
+	currentThreat, ok := t.threat[current]
+	if ok && topThreat <= currentThreat*(1+margin) {
+		return current
+	}
+
+	return top
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestThreatDecay(t *testing.T) {
+	threat := NewThreatTable()
+	threat.Add("a", 10)
+	threat.Add("b", MIN_THREAT*1.1)
+
+	now := time.Now()
+	if elapsed := threat.Decay(now, 0.1); elapsed != 0 {
+		t.Errorf("first decay elapsed = %f, want 0", elapsed)
+	}
+	if threat.Get("a") != 10 {
+		t.Errorf("first decay changed the threat to %f", threat.Get("a"))
+	}
+
+	elapsed := threat.Decay(now.Add(2*time.Second), 0.1)
+	if elapsed != 2 {
+		t.Errorf("elapsed = %f, want 2", elapsed)
+	}
+	if got := threat.Get("a"); math.Abs(got-8) > 1e-9 {
+		t.Errorf("threat after 2s at 10%%/s = %f, want 8", got)
+	}
+	if threat.Has("b") {
+		t.Error("threat below MIN_THREAT is kept")
+	}
+
+	// Decay never goes negative
+	threat.Decay(now.Add(time.Hour), 0.1)
+	if !threat.IsEmpty() {
+		t.Errorf("threat is left after full decay: %v", threat.GetUUIDs())
+	}
+}
+
+func TestThreatSelectTargetMargin(t *testing.T) {
+	threat := NewThreatTable()
+	threat.Add("current", 100)
+	threat.Add("other", 110)
+
+	if target := threat.SelectTarget("current", 0.1); target != "current" {
+		t.Errorf("exactly 10%% more threat took the target over: %s", target)
+	}
+
+	threat.Add("other", 0.5)
+	if target := threat.SelectTarget("current", 0.1); target != "other" {
+		t.Errorf("more than 10%% more threat didn't take the target over: %s", target)
+	}
+}
+
+func TestThreatSelectTargetWithoutCurrent(t *testing.T) {
+	threat := NewThreatTable()
+	if target := threat.SelectTarget("", 0.1); target != "" {
+		t.Errorf("empty table selected %q", target)
+	}
+
+	threat.Add("low", 1)
+	threat.Add("high", 2)
+	if target := threat.SelectTarget("", 0.1); target != "high" {
+		t.Errorf("target = %q, want high", target)
+	}
+
+	// The current target left the table, the top one is picked without the margin
+	if target := threat.SelectTarget("gone", 0.1); target != "high" {
+		t.Errorf("target = %q, want high", target)
+	}
+}
+
+func TestThreatSelectTargetTieBreak(t *testing.T) {
+	threat := NewThreatTable()
+	threat.Add("c", 5)
+	threat.Add("a", 5)
+	threat.Add("b", 5)
+
+	for i := 0; i < 10; i++ {
+		if target := threat.SelectTarget("", 0.1); target != "a" {
+			t.Fatalf("tie picked %q, want the lowest UUID a", target)
+		}
+	}
+
+	// The current target keeps a tie
+	if target := threat.SelectTarget("b", 0.1); target != "b" {
+		t.Errorf("tie switched the current target to %q", target)
+	}
+}
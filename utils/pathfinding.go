@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
-	"log"
 	"math"
 	"sort"
 )
@@ -34,12 +33,12 @@ const maxIterations = 1000
 
 var grid [][]*Node
 
-func init() {
+// LoadGrid reads the navigation grid, paths are empty until it is loaded
+func LoadGrid(filename string) error {
 	var err error
-	grid, err = loadGridData(FILE_PATH)
-	if err != nil {
-		log.Fatal("Error int grid file reading: ", err)
-	}
+	grid, err = loadGridData(filename)
+
+	return err
 }
 
 func loadGridData(filename string) ([][]*Node, error) {